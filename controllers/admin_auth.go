@@ -0,0 +1,22 @@
+package controllers
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAuth exige o cabeçalho "Authorization: Bearer <token>". Sem token
+// configurado, todas as requisições administrativas são recusadas.
+func AdminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provided, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if token == "" || !found || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, Error{Message: "Não autorizado"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/emvnuel/payroll/models"
+	"github.com/gin-gonic/gin"
+)
+
+type PayrollComparisonController struct {
+	store models.TaxRulesStore
+}
+
+type PayrollComparisonResponse struct {
+	Base                    *PayrollResponse             `json:"base"`
+	Target                  *PayrollResponse             `json:"target"`
+	NetPayDifference        float64                      `json:"netPayDifference"`
+	TotalDiscountDifference float64                      `json:"totalDiscountDifference"`
+	Discounts               []DiscountDifferenceResponse `json:"discounts"`
+}
+
+type DiscountDifferenceResponse struct {
+	Name       string  `json:"name"`
+	Base       float64 `json:"base"`
+	Target     float64 `json:"target"`
+	Difference float64 `json:"difference"`
+}
+
+func NewPayrollComparisonController(store models.TaxRulesStore) *PayrollComparisonController {
+	return &PayrollComparisonController{store: store}
+}
+
+func NewPayrollComparisonResponse(c *models.PayrollComparison, baseCompetence, targetCompetence models.Competence) *PayrollComparisonResponse {
+	differences := make([]DiscountDifferenceResponse, len(c.Discounts))
+	for i, difference := range c.Discounts {
+		differences[i] = DiscountDifferenceResponse{
+			Name:       difference.Name,
+			Base:       difference.Base.RoundBank(2).InexactFloat64(),
+			Target:     difference.Target.RoundBank(2).InexactFloat64(),
+			Difference: difference.Difference().RoundBank(2).InexactFloat64(),
+		}
+	}
+
+	return &PayrollComparisonResponse{
+		Base:                    NewPayrollResponse(c.Base, baseCompetence),
+		Target:                  NewPayrollResponse(c.Target, targetCompetence),
+		NetPayDifference:        c.NetPayDifference().RoundBank(2).InexactFloat64(),
+		TotalDiscountDifference: c.TotalDiscountDifference().RoundBank(2).InexactFloat64(),
+		Discounts:               differences,
+	}
+}
+
+// @Summary Compare Payroll
+// @Description Calculates the same payroll under two tax table versions and/or competences and returns both results with the per-discount differences (target minus base). Business days, rest days and the prorated salary are computed for each side's competence.
+// @Tags payroll
+// @Param grossPay query number true "Gross pay of the employee. Accepts the same earning and discount parameters as /payroll"
+// @Param numberOfDependents query integer true "Number of dependents of the employee" minimum(0)
+// @Param fixedAmountDiscount query number true "Value of the fixed amount discount" minimum(0)
+// @Param percentangeDiscount query number true "Percentage discount value (between 0 and 1)" minimum(0) maximum(1)
+// @Param competence query string false "Competence month (YYYY-MM) used when baseCompetence or targetCompetence are omitted, defaults to the current month"
+// @Param baseCompetence query string false "Competence month (YYYY-MM) of the base calculation"
+// @Param targetCompetence query string false "Competence month (YYYY-MM) of the target calculation"
+// @Param baseVersion query string false "Tax rules version of the base calculation, defaults to the active version"
+// @Param targetVersion query string false "Tax rules version of the target calculation, defaults to the active version"
+// @Produce  json
+// @Success 200 {object} controllers.PayrollComparisonResponse "Both payrolls and their differences"
+// @Failure 400 {object} controllers.Error "Invalid fields provided"
+// @Failure 404 {object} controllers.Error "Tax rules version not found"
+// @Failure 500 {object} controllers.Error "Tax tables could not be loaded"
+// @Router /payroll/compare [get]
+func (pc *PayrollComparisonController) Compare(c *gin.Context) {
+	params, err := parseSharedParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	baseCompetence, err := parseCompetenceOrDefault(c.Query("baseCompetence"), params.competence)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+	targetCompetence, err := parseCompetenceOrDefault(c.Query("targetCompetence"), params.competence)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	baseConfig, err := pc.configFor(c.Query("baseVersion"), baseCompetence)
+	if err != nil {
+		respondTaxConfigError(c, err, baseCompetence)
+		return
+	}
+	targetConfig, err := pc.configFor(c.Query("targetVersion"), targetCompetence)
+	if err != nil {
+		respondTaxConfigError(c, err, targetCompetence)
+		return
+	}
+
+	// Os dias do mês e o salário proporcional são recalculados em cada lado
+	baseParams, err := paramsForCompetence(c, params, baseCompetence)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+	targetParams, err := paramsForCompetence(c, params, targetCompetence)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	basePayroll, err := newPayroll(baseParams, baseConfig)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+	targetPayroll, err := newPayroll(targetParams, targetConfig)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	comparison := models.NewPayrollComparison(basePayroll, targetPayroll)
+
+	c.JSON(http.StatusOK, NewPayrollComparisonResponse(comparison, baseCompetence, targetCompetence))
+}
+
+// configFor usa a versão publicada informada ou, se vazia, a versão ativa
+func (pc *PayrollComparisonController) configFor(version string, competence models.Competence) (*models.TaxConfig, error) {
+	rules := models.CurrentTaxRules()
+	if version != "" {
+		var err error
+		if rules, err = pc.store.Get(version); err != nil {
+			return nil, err
+		}
+	}
+	return rules.ConfigFor(competence)
+}
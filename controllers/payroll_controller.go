@@ -1,21 +1,63 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/emvnuel/payroll/models"
 	"github.com/gin-gonic/gin"
 	"github.com/shopspring/decimal"
 )
 
+const (
+	// defaultMonthlyHours é a jornada mensal de 44 horas semanais
+	defaultMonthlyHours = 220
+
+	payModeMonthly = "monthly"
+	payModeHourly  = "hourly"
+)
+
+// allowanceQueryParams são os parâmetros de cada tipo de verba indenizatória
+var allowanceQueryParams = map[models.AllowanceType]string{
+	models.AllowancePerDiem:       "perDiem",
+	models.AllowanceRelocation:    "relocationAllowance",
+	models.AllowanceReimbursement: "reimbursement",
+	models.AllowanceBonus:         "bonus",
+}
+
 type PayrollResponse struct {
-	GrossPay      float64            `json:"grossPay"`
-	NetPay        float64            `json:"netPay"`
-	TotalDiscount float64            `json:"totalDiscount"`
-	Discounts     []DiscountResponse `json:"discounts"`
+	Competence      string             `json:"competence"`
+	TaxRulesVersion string             `json:"taxRulesVersion"`
+	GrossPay        float64            `json:"grossPay"`
+	NetPay          float64            `json:"netPay"`
+	TotalDiscount   float64            `json:"totalDiscount"`
+	INSSBase        float64            `json:"inssBase"`
+	IRRFBase        float64            `json:"irrfBase"`
+	FGTSBase        float64            `json:"fgtsBase"`
+	TotalBenefits   float64            `json:"totalBenefits"`
+	Earnings        []EarningResponse  `json:"earnings"`
+	Benefits        []EarningResponse  `json:"benefits"`
+	Discounts       []DiscountResponse `json:"discounts"`
+}
+
+type EarningResponse struct {
+	Value     float64            `json:"value"`
+	Name      string             `json:"name"`
+	Incidence models.Incidence   `json:"incidence"`
+	Proration *ProrationResponse `json:"proration,omitempty"`
+}
+
+// ProrationResponse mostra de onde vem um provento proporcional: o valor do
+// mês inteiro e os dias trabalhados sobre os dias do mês
+type ProrationResponse struct {
+	MonthlyValue float64 `json:"monthlyValue"`
+	DaysWorked   int     `json:"daysWorked"`
+	MonthDays    int     `json:"monthDays"`
 }
 
 type DiscountResponse struct {
@@ -31,7 +73,7 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
-func NewPayrollResponse(p *models.Payroll) *PayrollResponse {
+func NewPayrollResponse(p *models.Payroll, competence models.Competence) *PayrollResponse {
 	discountsResponse := make([]DiscountResponse, len(p.Discounts))
 	for i, discount := range p.Discounts {
 		discountsResponse[i] = DiscountResponse{
@@ -41,23 +83,87 @@ func NewPayrollResponse(p *models.Payroll) *PayrollResponse {
 	}
 
 	return &PayrollResponse{
-		GrossPay:      p.GrossPay.RoundBank(2).InexactFloat64(),
-		NetPay:        p.NetPay().RoundBank(2).InexactFloat64(),
-		TotalDiscount: p.TotalDiscount().RoundBank(2).InexactFloat64(),
-		Discounts:     discountsResponse,
+		Competence:      competence.String(),
+		TaxRulesVersion: p.TaxConfig.Version,
+		GrossPay:        p.GrossPay.RoundBank(2).InexactFloat64(),
+		NetPay:          p.NetPay().RoundBank(2).InexactFloat64(),
+		TotalDiscount:   p.TotalDiscount().RoundBank(2).InexactFloat64(),
+		INSSBase:        p.INSSBase().RoundBank(2).InexactFloat64(),
+		IRRFBase:        p.IRRFBase().RoundBank(2).InexactFloat64(),
+		FGTSBase:        p.FGTSBase().RoundBank(2).InexactFloat64(),
+		TotalBenefits:   p.TotalBenefits().RoundBank(2).InexactFloat64(),
+		Earnings:        newEarningsResponse(p.Earnings),
+		Benefits:        newEarningsResponse(p.Benefits),
+		Discounts:       discountsResponse,
+	}
+}
+
+func newEarningsResponse(earnings []models.Earning) []EarningResponse {
+	earningsResponse := make([]EarningResponse, len(earnings))
+	for i, earning := range earnings {
+		earningsResponse[i] = EarningResponse{
+			Value:     earning.Value().RoundBank(2).InexactFloat64(),
+			Name:      earning.Name(),
+			Incidence: earning.Incidence(),
+			Proration: newProrationResponse(earning),
+		}
+	}
+	return earningsResponse
+}
+
+func newProrationResponse(earning models.Earning) *ProrationResponse {
+	prorated, ok := earning.(models.ProratedEarning)
+	if !ok {
+		return nil
+	}
+	monthlyValue, proration := prorated.Prorated()
+	if proration.IsFull() {
+		return nil
+	}
+	return &ProrationResponse{
+		MonthlyValue: monthlyValue.RoundBank(2).InexactFloat64(),
+		DaysWorked:   proration.DaysWorked,
+		MonthDays:    proration.MonthDays,
 	}
 }
 
 // @Summary Calculate Payroll
 // @Description This endpoint calculates the net pay based on gross pay, number of dependents, and applied discounts. The IRRF calculation automatically uses the most favorable method (simplified deduction vs dependent deduction).
 // @Tags payroll
-// @Param grossPay query number true "Gross pay of the employee"
+// @Param payMode query string false "Pay mode, monthly salary or hourly worker (horista), defaults to monthly" Enums(monthly, hourly)
+// @Param grossPay query number false "Monthly salary of the employee, required in the monthly pay mode"
+// @Param admissionDate query string false "Admission date (YYYY-MM-DD) within the competence in the monthly pay mode, prorates the monthly salary and the unhealthy work premium"
+// @Param terminationDate query string false "Termination date (YYYY-MM-DD) within the competence in the monthly pay mode, prorates the monthly salary and the unhealthy work premium"
+// @Param prorationConvention query string false "How days are counted in the prorated salary, defaults to the commercial 30-day month" Enums(commercial, calendar)
+// @Param hourlyRate query number false "Hourly rate, required in the hourly pay mode" minimum(0)
+// @Param hoursWorked query number false "Hours worked in the month, required in the hourly pay mode" minimum(0)
 // @Param numberOfDependents query integer true "Number of dependents of the employee" minimum(0)
+// @Param familyAllowanceChildren query integer false "Children under 14 or disabled entitled to the family allowance (salário-família)" minimum(0)
 // @Param fixedAmountDiscount query number true "Value of the fixed amount discount" minimum(0)
 // @Param percentangeDiscount query number true "Percentage discount value (between 0 and 1)" minimum(0) maximum(1)
+// @Param competence query string false "Competence month (YYYY-MM) whose tax tables are applied, defaults to the current month"
+// @Param monthlyHours query number false "Contractual monthly hours used to compute the hourly rate in the monthly pay mode, defaults to 220" minimum(0)
+// @Param overtimeHours50 query number false "Overtime hours paid with a 50% premium" minimum(0)
+// @Param overtimeHours100 query number false "Overtime hours on Sundays and holidays paid with a 100% premium" minimum(0)
+// @Param businessDays query integer false "Business days in the month used in the DSR reflection, defaults to the days of the competence except Sundays" minimum(1)
+// @Param restDays query integer false "Rest days (Sundays and holidays) in the month used in the DSR reflection, defaults to the Sundays of the competence" minimum(0)
+// @Param nightHours query number false "Clock hours worked between 22h and 5h, converted using the 52m30s night hour" minimum(0)
+// @Param nightPremium query number false "Night shift premium (between 0 and 1), defaults to 0.2" minimum(0) maximum(1)
+// @Param hazardous query boolean false "Whether the employee is entitled to the 30% hazard premium (periculosidade)"
+// @Param insalubrityGrade query string false "Unhealthy work grade (insalubridade)" Enums(minimum, medium, maximum)
+// @Param minimumWage query number false "Minimum wage reference for the unhealthy work premium, defaults to the one in the competence tax table" minimum(0)
+// @Param commission query number false "Commissions earned in the month, with DSR computed from the business and rest days" minimum(0)
+// @Param perDiem query number false "Travel per diems (diárias), not subject to INSS, IRRF and FGTS by default" minimum(0)
+// @Param relocationAllowance query number false "Relocation allowance (ajuda de custo), not subject to INSS, IRRF and FGTS by default" minimum(0)
+// @Param reimbursement query number false "Expense reimbursements, not subject to INSS, IRRF and FGTS by default" minimum(0)
+// @Param bonus query number false "Performance bonuses (prêmios), subject only to IRRF by default" minimum(0)
+// @Param absenceDays query number false "Unjustified absence days in the monthly pay mode, deducted from the INSS and IRRF bases" minimum(0)
+// @Param lostRestDays query integer false "Rest days lost in weeks with unjustified absences, deducted by the daily rate or, in the hourly pay mode, by the DSR of one rest day" minimum(0)
+// @Param lateHours query number false "Late arrival hours in the monthly pay mode, deducted from the INSS and IRRF bases" minimum(0)
 // @Produce  json
 // @Success 200 {object} controllers.PayrollResponse "Payroll information"
 // @Failure 400 {object} controllers.Error "Invalid fields provided"
+// @Failure 500 {object} controllers.Error "Tax tables could not be loaded"
 // @Router /payroll [get]
 func GetPayroll(c *gin.Context) {
 	params, err := parseAndValidateParams(c)
@@ -66,48 +172,196 @@ func GetPayroll(c *gin.Context) {
 		return
 	}
 
-	fixedDiscount := models.NewFixedAmountDiscount(decimal.NewFromFloat(params.fixedAmountDiscount))
-	percentageDiscount := models.NewPercentageDiscount(
-		decimal.NewFromFloat(params.grossPay),
-		decimal.NewFromFloat(params.percentageDiscount),
+	config, ok := activeTaxConfig(c, params.competence)
+	if !ok {
+		return
+	}
+
+	payroll, err := newPayroll(params, config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, NewPayrollResponse(payroll, params.competence))
+}
+
+// newPayroll monta os proventos e os descontos informados e calcula a folha
+// com a tabela da competência
+func newPayroll(params *payrollParams, config *models.TaxConfig) (*models.Payroll, error) {
+	minimumWage := config.MinimumWage
+	if params.minimumWage > 0 {
+		minimumWage = decimal.NewFromFloat(params.minimumWage)
+	}
+	if params.insalubrityGrade != models.InsalubrityNone && !minimumWage.IsPositive() {
+		return nil, &Error{Message: fmt.Sprintf("Salário mínimo de referência não configurado para a competência %s", params.competence)}
+	}
+
+	monthlyHours := decimal.NewFromFloat(params.monthlyHours)
+	basis := newPayBasis(params)
+	earnings := basis.earnings
+	if premium := models.WorkConditionPremium(basis.baseSalary, params.hazardous, minimumWage, params.insalubrityGrade, params.proration); premium != nil {
+		earnings = append(earnings, premium)
+	}
+	earnings = append(earnings, models.CommissionEarnings(decimal.NewFromFloat(params.commission), params.workMonth)...)
+	earnings = append(earnings, models.OvertimeEarnings(
+		basis.monthlySalary,
+		monthlyHours,
+		decimal.NewFromFloat(params.overtimeHours50),
+		decimal.NewFromFloat(params.overtimeHours100),
+		params.workMonth,
+	)...)
+	earnings = append(earnings, models.NightShiftEarnings(
+		basis.monthlySalary,
+		monthlyHours,
+		decimal.NewFromFloat(params.nightHours),
+		decimal.NewFromFloat(params.nightPremium),
+		params.workMonth,
+	)...)
+
+	for _, allowanceType := range models.AllowanceTypes() {
+		if amount := params.allowances[allowanceType]; amount > 0 {
+			earnings = append(earnings, models.NewAllowance(allowanceType, decimal.NewFromFloat(amount), config.AllowanceIncidence(allowanceType)))
+		}
+	}
+
+	discounts := models.AbsenceDiscounts(
+		basis.dailyRate,
+		basis.hourlyRate,
+		decimal.NewFromFloat(params.absenceDays),
+		params.lostRestDays,
+		decimal.NewFromFloat(params.lateHours),
+	)
+	discounts = append(discounts,
+		models.NewFixedAmountDiscount(decimal.NewFromFloat(params.fixedAmountDiscount)),
+		models.NewPercentageDiscount(
+			basis.baseSalary,
+			decimal.NewFromFloat(params.percentageDiscount),
+		),
 	)
 
-	payroll := models.NewPayroll(
-		decimal.NewFromFloat(params.grossPay),
+	payroll := models.NewPayrollFromEarnings(
+		earnings,
 		int64(params.numberOfDependents),
-		fixedDiscount,
-		percentageDiscount,
+		config,
+		discounts...,
 	)
+	payroll.AddFamilyAllowance(int64(params.familyAllowanceChildren))
+	return payroll, nil
+}
+
+// payBasis é o salário conforme a forma de pagamento e as referências usadas
+// pelos demais proventos e descontos
+type payBasis struct {
+	earnings []models.Earning
+	// baseSalary é a referência da periculosidade e do desconto percentual
+	baseSalary decimal.Decimal
+	// monthlySalary é o salário mensal integral de que as horas extras e o
+	// adicional noturno tiram a hora normal
+	monthlySalary decimal.Decimal
+	// hourlyRate é a hora normal dos atrasos
+	hourlyRate decimal.Decimal
+	// dailyRate é o valor do dia das faltas e da perda do DSR. O horista não
+	// tem faltas descontadas em dias, e o valor é o de um dia de descanso.
+	dailyRate decimal.Decimal
+}
 
-	c.JSON(http.StatusOK, NewPayrollResponse(payroll))
+func newPayBasis(params *payrollParams) payBasis {
+	monthlyHours := decimal.NewFromFloat(params.monthlyHours)
+
+	if params.payMode == payModeHourly {
+		hourlyRate := decimal.NewFromFloat(params.hourlyRate)
+		earnings := models.HourlyPayEarnings(hourlyRate, decimal.NewFromFloat(params.hoursWorked), params.workMonth)
+		baseSalary := decimal.Zero
+		for _, earning := range earnings {
+			baseSalary = baseSalary.Add(earning.Value())
+		}
+		dsr := earnings[len(earnings)-1].Value()
+		return payBasis{
+			earnings:      earnings,
+			baseSalary:    baseSalary,
+			monthlySalary: hourlyRate.Mul(monthlyHours),
+			hourlyRate:    hourlyRate,
+			dailyRate:     models.RestDayRate(dsr, params.workMonth),
+		}
+	}
+
+	grossPay := decimal.NewFromFloat(params.grossPay)
+	basis := payBasis{
+		earnings:      []models.Earning{models.NewBaseSalary(grossPay)},
+		baseSalary:    grossPay,
+		monthlySalary: grossPay,
+		hourlyRate:    models.HourlyRate(grossPay, monthlyHours),
+		dailyRate:     models.DailyRate(grossPay),
+	}
+	if !params.proration.IsFull() {
+		salary := models.NewProportionalSalary(grossPay, params.proration)
+		basis.earnings = []models.Earning{salary}
+		basis.baseSalary = salary.Value()
+	}
+	return basis
 }
 
 type payrollParams struct {
-	grossPay            float64
-	numberOfDependents  int
-	fixedAmountDiscount float64
-	percentageDiscount  float64
+	payMode                 string
+	grossPay                float64
+	hourlyRate              float64
+	hoursWorked             float64
+	proration               models.Proration
+	numberOfDependents      int
+	familyAllowanceChildren int
+	fixedAmountDiscount     float64
+	percentageDiscount      float64
+	competence              models.Competence
+	monthlyHours            float64
+	overtimeHours50         float64
+	overtimeHours100        float64
+	workMonth               models.WorkMonth
+	nightHours              float64
+	nightPremium            float64
+	hazardous               bool
+	insalubrityGrade        models.InsalubrityGrade
+	minimumWage             float64
+	commission              float64
+	allowances              map[models.AllowanceType]float64
+	absenceDays             float64
+	lostRestDays            int
+	lateHours               float64
 }
 
 func parseAndValidateParams(c *gin.Context) (*payrollParams, error) {
-	grossPay, err1 := strconv.ParseFloat(c.Query("grossPay"), 64)
-	numberOfDependents, err2 := strconv.Atoi(c.Query("numberOfDependents"))
-	fixedAmountDiscount, err3 := strconv.ParseFloat(c.Query("fixedAmountDiscount"), 64)
-	percentageDiscount, err4 := strconv.ParseFloat(c.Query("percentangeDiscount"), 64)
+	params, err := parseSharedParams(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := parseCompetenceParams(c, params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
 
-	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
-		return nil, &Error{Message: "Campos inválidos"}
+// parseSharedParams lê os parâmetros que não dependem da competência, comuns
+// à folha e à comparação
+func parseSharedParams(c *gin.Context) (*payrollParams, error) {
+	numberOfDependents, err := parseNumberOfDependents(c)
+	if err != nil {
+		return nil, err
 	}
 
-	if numberOfDependents < 0 {
-		return nil, &Error{Message: "Número de dependentes não pode ser negativo"}
+	fixedAmountDiscount, err1 := parseFiniteFloat(c.Query("fixedAmountDiscount"))
+	percentageDiscount, err2 := parseFiniteFloat(c.Query("percentangeDiscount"))
+
+	if err1 != nil || err2 != nil {
+		return nil, &Error{Message: "Campos inválidos"}
 	}
 
-	minGrossPay := os.Getenv("MIN_GROSS_PAY")
-	minGrossPayFloat, _ := strconv.ParseFloat(minGrossPay, 64)
+	familyAllowanceChildren, err := parseOptionalInt(c, "familyAllowanceChildren", 0)
+	if err != nil {
+		return nil, &Error{Message: "Campos inválidos"}
+	}
 
-	if grossPay < minGrossPayFloat {
-		return nil, &Error{Message: fmt.Sprintf("Salário bruto deve ser maior ou igual a R$%.2f", minGrossPayFloat)}
+	if familyAllowanceChildren < 0 {
+		return nil, &Error{Message: "Número de filhos para o salário-família não pode ser negativo"}
 	}
 
 	if percentageDiscount < 0 || percentageDiscount > 1 {
@@ -118,10 +372,402 @@ func parseAndValidateParams(c *gin.Context) (*payrollParams, error) {
 		return nil, &Error{Message: "Valor fixo não pode ser negativo"}
 	}
 
-	return &payrollParams{
-		grossPay:            grossPay,
-		numberOfDependents:  numberOfDependents,
-		fixedAmountDiscount: fixedAmountDiscount,
-		percentageDiscount:  percentageDiscount,
-	}, nil
+	competence, err := parseCompetenceParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &payrollParams{
+		numberOfDependents:      numberOfDependents,
+		familyAllowanceChildren: familyAllowanceChildren,
+		fixedAmountDiscount:     fixedAmountDiscount,
+		percentageDiscount:      percentageDiscount,
+		competence:              competence,
+	}
+	if err := parseOvertimeParams(c, params); err != nil {
+		return nil, err
+	}
+	if err := parsePayModeParams(c, params); err != nil {
+		return nil, err
+	}
+	if err := parseNightShiftParams(c, params); err != nil {
+		return nil, err
+	}
+	if err := parseWorkConditionParams(c, params); err != nil {
+		return nil, err
+	}
+	if err := parseAbsenceParams(c, params); err != nil {
+		return nil, err
+	}
+	if err := parseCommissionParams(c, params); err != nil {
+		return nil, err
+	}
+	if err := parseAllowanceParams(c, params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// paramsForCompetence copia os parâmetros para outra competência, recalculando
+// os dias do mês e o salário proporcional, que dependem dela
+func paramsForCompetence(c *gin.Context, params *payrollParams, competence models.Competence) (*payrollParams, error) {
+	competenceParams := *params
+	competenceParams.competence = competence
+	if err := parseCompetenceParams(c, &competenceParams); err != nil {
+		return nil, err
+	}
+	return &competenceParams, nil
+}
+
+// parseCompetenceParams lê os parâmetros que dependem da competência: os dias
+// úteis e de descanso do mês e, no salário mensal, o período trabalhado
+func parseCompetenceParams(c *gin.Context, params *payrollParams) error {
+	if err := parseWorkMonthParams(c, params); err != nil {
+		return err
+	}
+	if params.lostRestDays > params.workMonth.RestDays {
+		return &Error{Message: "Dias de descanso perdidos não podem exceder os dias de descanso do mês"}
+	}
+	if params.payMode == payModeMonthly {
+		return parseProrationParams(c, params)
+	}
+	return nil
+}
+
+// parsePayModeParams lê o salário mensal (grossPay) ou, para horistas, o
+// valor da hora e as horas trabalhadas no mês
+func parsePayModeParams(c *gin.Context, params *payrollParams) error {
+	params.payMode = c.DefaultQuery("payMode", payModeMonthly)
+
+	switch params.payMode {
+	case payModeMonthly:
+		grossPay, err := parseFiniteFloat(c.Query("grossPay"))
+		if err != nil {
+			return &Error{Message: "Campos inválidos"}
+		}
+
+		minGrossPay := os.Getenv("MIN_GROSS_PAY")
+		minGrossPayFloat, _ := strconv.ParseFloat(minGrossPay, 64)
+
+		if grossPay < minGrossPayFloat {
+			return &Error{Message: fmt.Sprintf("Salário bruto deve ser maior ou igual a R$%.2f", minGrossPayFloat)}
+		}
+
+		params.grossPay = grossPay
+	case payModeHourly:
+		hourlyRate, err1 := parseFiniteFloat(c.Query("hourlyRate"))
+		hoursWorked, err2 := parseFiniteFloat(c.Query("hoursWorked"))
+
+		if err1 != nil || err2 != nil {
+			return &Error{Message: "Campos inválidos"}
+		}
+
+		if hourlyRate <= 0 {
+			return &Error{Message: "Valor da hora deve ser maior que zero"}
+		}
+
+		if hoursWorked < 0 {
+			return &Error{Message: "Horas trabalhadas não podem ser negativas"}
+		}
+
+		if c.Query("admissionDate") != "" || c.Query("terminationDate") != "" {
+			return &Error{Message: "Datas de admissão e desligamento não se aplicam ao horista, informe só as horas trabalhadas"}
+		}
+
+		params.hourlyRate = hourlyRate
+		params.hoursWorked = hoursWorked
+	default:
+		return &Error{Message: "Forma de pagamento deve ser monthly ou hourly"}
+	}
+	return nil
+}
+
+// parseProrationParams lê as datas de admissão e de desligamento (AAAA-MM-DD)
+// e calcula os dias trabalhados na competência para o salário proporcional
+func parseProrationParams(c *gin.Context, params *payrollParams) error {
+	admission, err1 := parseOptionalDate(c, "admissionDate")
+	termination, err2 := parseOptionalDate(c, "terminationDate")
+
+	if err1 != nil || err2 != nil {
+		return &Error{Message: "Data inválida, use o formato AAAA-MM-DD"}
+	}
+
+	convention, err := models.ParseProrationConvention(c.DefaultQuery("prorationConvention", string(models.ProrationCommercial)))
+	if err != nil {
+		return &Error{Message: "Convenção de proporcionalidade deve ser commercial ou calendar"}
+	}
+
+	proration, err := models.NewProration(params.competence, admission, termination, convention)
+	if err != nil {
+		return &Error{Message: fmt.Sprintf("Período trabalhado não pertence à competência %s", params.competence)}
+	}
+
+	params.proration = proration
+	return nil
+}
+
+func parseOptionalDate(c *gin.Context, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// parseOvertimeParams lê a jornada mensal e as horas extras
+func parseOvertimeParams(c *gin.Context, params *payrollParams) error {
+	monthlyHours, err1 := parseOptionalFloat(c, "monthlyHours", defaultMonthlyHours)
+	overtimeHours50, err2 := parseOptionalFloat(c, "overtimeHours50", 0)
+	overtimeHours100, err3 := parseOptionalFloat(c, "overtimeHours100", 0)
+
+	if err1 != nil || err2 != nil || err3 != nil {
+		return &Error{Message: "Campos inválidos"}
+	}
+
+	if monthlyHours <= 0 {
+		return &Error{Message: "Jornada mensal deve ser maior que zero"}
+	}
+
+	if overtimeHours50 < 0 || overtimeHours100 < 0 {
+		return &Error{Message: "Horas extras não podem ser negativas"}
+	}
+
+	params.monthlyHours = monthlyHours
+	params.overtimeHours50 = overtimeHours50
+	params.overtimeHours100 = overtimeHours100
+	return nil
+}
+
+// parseWorkMonthParams lê os dias úteis e de descanso usados no DSR, que
+// quando omitidos são calculados a partir da competência
+func parseWorkMonthParams(c *gin.Context, params *payrollParams) error {
+	defaultMonth := models.WorkMonthOf(params.competence)
+
+	businessDays, err1 := parseOptionalInt(c, "businessDays", defaultMonth.BusinessDays)
+	restDays, err2 := parseOptionalInt(c, "restDays", defaultMonth.RestDays)
+
+	if err1 != nil || err2 != nil {
+		return &Error{Message: "Campos inválidos"}
+	}
+
+	workMonth, err := models.NewWorkMonth(businessDays, restDays)
+	if err != nil {
+		return &Error{Message: "Dias úteis devem ser maiores que zero e dias de descanso não podem ser negativos"}
+	}
+
+	params.workMonth = workMonth
+	return nil
+}
+
+// parseNightShiftParams lê as horas noturnas de relógio e o percentual do
+// adicional noturno, que por padrão é de 20%
+func parseNightShiftParams(c *gin.Context, params *payrollParams) error {
+	nightHours, err1 := parseOptionalFloat(c, "nightHours", 0)
+	nightPremium, err2 := parseOptionalFloat(c, "nightPremium", models.DefaultNightShiftPremium.InexactFloat64())
+
+	if err1 != nil || err2 != nil {
+		return &Error{Message: "Campos inválidos"}
+	}
+
+	if nightHours < 0 {
+		return &Error{Message: "Horas noturnas não podem ser negativas"}
+	}
+
+	if nightPremium < 0 || nightPremium > 1 {
+		return &Error{Message: "Adicional noturno deve ser entre 0 e 1"}
+	}
+
+	params.nightHours = nightHours
+	params.nightPremium = nightPremium
+	return nil
+}
+
+// parseWorkConditionParams lê a periculosidade, o grau de insalubridade e o
+// salário mínimo de referência, que por padrão vem da tabela da competência
+func parseWorkConditionParams(c *gin.Context, params *payrollParams) error {
+	hazardous, err1 := parseOptionalBool(c, "hazardous", false)
+	minimumWage, err2 := parseOptionalFloat(c, "minimumWage", 0)
+
+	if err1 != nil || err2 != nil {
+		return &Error{Message: "Campos inválidos"}
+	}
+
+	grade, err := models.ParseInsalubrityGrade(c.Query("insalubrityGrade"))
+	if err != nil {
+		return &Error{Message: "Grau de insalubridade deve ser minimum, medium ou maximum"}
+	}
+
+	if minimumWage < 0 {
+		return &Error{Message: "Salário mínimo não pode ser negativo"}
+	}
+
+	params.hazardous = hazardous
+	params.insalubrityGrade = grade
+	params.minimumWage = minimumWage
+	return nil
+}
+
+// parseAbsenceParams lê as faltas injustificadas em dias, os dias de descanso
+// perdidos nas semanas com falta e os atrasos em horas
+func parseAbsenceParams(c *gin.Context, params *payrollParams) error {
+	absenceDays, err1 := parseOptionalFloat(c, "absenceDays", 0)
+	lostRestDays, err2 := parseOptionalInt(c, "lostRestDays", 0)
+	lateHours, err3 := parseOptionalFloat(c, "lateHours", 0)
+
+	if err1 != nil || err2 != nil || err3 != nil {
+		return &Error{Message: "Campos inválidos"}
+	}
+
+	if absenceDays < 0 || lostRestDays < 0 || lateHours < 0 {
+		return &Error{Message: "Faltas e atrasos não podem ser negativos"}
+	}
+
+	// O horista recebe só as horas trabalhadas, e descontar as faltas e os
+	// atrasos cobraria duas vezes as horas que ele já não recebeu
+	if params.payMode == payModeHourly && (absenceDays > 0 || lateHours > 0) {
+		return &Error{Message: "Faltas e atrasos do horista já ficam fora das horas trabalhadas, informe só os dias de descanso perdidos"}
+	}
+
+	params.absenceDays = absenceDays
+	params.lostRestDays = lostRestDays
+	params.lateHours = lateHours
+	return nil
+}
+
+// parseCommissionParams lê as comissões do mês, cujo DSR usa os dias úteis e
+// de descanso da competência
+func parseCommissionParams(c *gin.Context, params *payrollParams) error {
+	commission, err := parseOptionalFloat(c, "commission", 0)
+	if err != nil {
+		return &Error{Message: "Campos inválidos"}
+	}
+
+	if commission < 0 {
+		return &Error{Message: "Comissões não podem ser negativas"}
+	}
+
+	params.commission = commission
+	return nil
+}
+
+// parseAllowanceParams lê os valores das verbas indenizatórias e dos prêmios
+func parseAllowanceParams(c *gin.Context, params *payrollParams) error {
+	params.allowances = make(map[models.AllowanceType]float64, len(allowanceQueryParams))
+	for allowanceType, key := range allowanceQueryParams {
+		amount, err := parseOptionalFloat(c, key, 0)
+		if err != nil {
+			return &Error{Message: "Campos inválidos"}
+		}
+
+		if amount < 0 {
+			return &Error{Message: "Verbas indenizatórias e prêmios não podem ser negativos"}
+		}
+
+		params.allowances[allowanceType] = amount
+	}
+	return nil
+}
+
+func parseOptionalBool(c *gin.Context, key string, defaultValue bool) (bool, error) {
+	value := c.Query(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+func parseOptionalFloat(c *gin.Context, key string, defaultValue float64) (float64, error) {
+	value := c.Query(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return parseFiniteFloat(value)
+}
+
+// parseFiniteFloat lê um número e recusa NaN e infinito, que ParseFloat aceita
+// mas que não são valores monetários nem quantidades válidas
+func parseFiniteFloat(value string) (float64, error) {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, fmt.Errorf("%q is not a finite number", value)
+	}
+	return number, nil
+}
+
+func parseOptionalInt(c *gin.Context, key string, defaultValue int) (int, error) {
+	value := c.Query(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.Atoi(value)
+}
+
+func parseOptionalDecimal(c *gin.Context, key string, defaultValue decimal.Decimal) (decimal.Decimal, error) {
+	value := c.Query(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	return decimal.NewFromString(value)
+}
+
+// parseNumberOfDependents lê o número de dependentes, obrigatório em todos os
+// cálculos com IRRF
+func parseNumberOfDependents(c *gin.Context) (int, error) {
+	numberOfDependents, err := strconv.Atoi(c.Query("numberOfDependents"))
+	if err != nil {
+		return 0, &Error{Message: "Campos inválidos"}
+	}
+
+	if numberOfDependents < 0 {
+		return 0, &Error{Message: "Número de dependentes não pode ser negativo"}
+	}
+	return numberOfDependents, nil
+}
+
+// parseCompetenceParam lê a competência (AAAA-MM), que por padrão é o mês corrente
+func parseCompetenceParam(c *gin.Context) (models.Competence, error) {
+	return parseCompetenceOrDefault(c.Query("competence"), models.CompetenceOf(time.Now()))
+}
+
+func parseCompetenceOrDefault(value string, defaultCompetence models.Competence) (models.Competence, error) {
+	if value == "" {
+		return defaultCompetence, nil
+	}
+	competence, err := models.ParseCompetence(value)
+	if err != nil {
+		return models.Competence{}, &Error{Message: "Competência inválida, use o formato AAAA-MM"}
+	}
+	return competence, nil
+}
+
+// activeTaxConfig busca nas regras ativas a tabela vigente na competência.
+// Quando não encontra, responde o erro e retorna false.
+func activeTaxConfig(c *gin.Context, competence models.Competence) (*models.TaxConfig, bool) {
+	config, err := models.CurrentTaxRules().ConfigFor(competence)
+	if err != nil {
+		respondTaxConfigError(c, err, competence)
+		return nil, false
+	}
+	return config, true
+}
+
+// respondTaxConfigError responde a falha na busca da tabela de impostos: 404
+// para versão inexistente, 400 para competência sem tabela vigente e 500 para
+// os demais erros
+func respondTaxConfigError(c *gin.Context, err error, competence models.Competence) {
+	switch {
+	case errors.Is(err, models.ErrTaxRulesVersionNotFound):
+		c.JSON(http.StatusNotFound, Error{Message: "Versão de tabelas não encontrada"})
+	case errors.Is(err, models.ErrTaxConfigNotFound):
+		c.JSON(http.StatusBadRequest, Error{Message: fmt.Sprintf("Nenhuma tabela de impostos vigente para a competência %s", competence)})
+	default:
+		c.JSON(http.StatusInternalServerError, Error{Message: "Erro ao carregar as tabelas de impostos"})
+	}
 }
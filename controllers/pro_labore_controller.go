@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/emvnuel/payroll/models"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+type ProLaboreResponse struct {
+	*PayrollResponse
+	EmployerContribution float64 `json:"employerContribution"`
+	TotalCost            float64 `json:"totalCost"`
+}
+
+func NewProLaboreResponse(p *models.ProLabore, competence models.Competence) *ProLaboreResponse {
+	return &ProLaboreResponse{
+		PayrollResponse:      NewPayrollResponse(p.Payroll, competence),
+		EmployerContribution: p.EmployerContribution().InexactFloat64(),
+		TotalCost:            p.TotalCost().RoundBank(2).InexactFloat64(),
+	}
+}
+
+// @Summary Calculate Pro-labore
+// @Description Calculates the pro-labore of a company partner: INSS of 11% up to the ceiling as contribuinte individual, IRRF by the monthly table and no FGTS. Also returns the 20% employer contribution paid by the company.
+// @Tags payroll
+// @Param grossPay query number true "Pro-labore amount" minimum(0)
+// @Param numberOfDependents query integer true "Number of dependents of the partner" minimum(0)
+// @Param competence query string false "Competence month (YYYY-MM) whose tax tables are applied, defaults to the current month"
+// @Produce  json
+// @Success 200 {object} controllers.ProLaboreResponse "Pro-labore with the employer contribution"
+// @Failure 400 {object} controllers.Error "Invalid fields provided"
+// @Failure 500 {object} controllers.Error "Tax tables could not be loaded"
+// @Router /payroll/pro-labore [get]
+func GetProLabore(c *gin.Context) {
+	amount, err := parseFiniteFloat(c.Query("grossPay"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: "Campos inválidos"})
+		return
+	}
+
+	if amount < 0 {
+		c.JSON(http.StatusBadRequest, Error{Message: "Pró-labore não pode ser negativo"})
+		return
+	}
+
+	numberOfDependents, err := parseNumberOfDependents(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	competence, err := parseCompetenceParam(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	config, ok := activeTaxConfig(c, competence)
+	if !ok {
+		return
+	}
+
+	proLabore := models.NewProLabore(decimal.NewFromFloat(amount), int64(numberOfDependents), config)
+	c.JSON(http.StatusOK, NewProLaboreResponse(proLabore, competence))
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emvnuel/payroll/models"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+type ProfitSharingResponse struct {
+	Competence        string  `json:"competence"`
+	TaxRulesVersion   string  `json:"taxRulesVersion"`
+	Amount            float64 `json:"amount"`
+	PreviousAmount    float64 `json:"previousAmount"`
+	AccumulatedAmount float64 `json:"accumulatedAmount"`
+	PreviousWithheld  float64 `json:"previousWithheld"`
+	IRRF              float64 `json:"irrf"`
+	NetPay            float64 `json:"netPay"`
+}
+
+func NewProfitSharingResponse(p *models.ProfitSharing, competence models.Competence) *ProfitSharingResponse {
+	return &ProfitSharingResponse{
+		Competence:        competence.String(),
+		TaxRulesVersion:   p.Config.Version,
+		Amount:            p.Amount.RoundBank(2).InexactFloat64(),
+		PreviousAmount:    p.PreviousAmount.RoundBank(2).InexactFloat64(),
+		AccumulatedAmount: p.AccumulatedAmount().RoundBank(2).InexactFloat64(),
+		PreviousWithheld:  p.PreviousWithheld.RoundBank(2).InexactFloat64(),
+		IRRF:              p.IRRF().InexactFloat64(),
+		NetPay:            p.NetPay().RoundBank(2).InexactFloat64(),
+	}
+}
+
+// @Summary Calculate Profit Sharing
+// @Description Calculates the IRRF withheld on a profit sharing (PLR) payment by the exclusive annual PLR table, without INSS. Payments in the same calendar year are added up and the tax already withheld on them is deducted.
+// @Tags payroll
+// @Param amount query number true "PLR amount paid now" minimum(0)
+// @Param previousAmount query number false "PLR already paid in the calendar year, defaults to 0" minimum(0)
+// @Param previousWithheld query number false "IRRF withheld on the PLR already paid, defaults to the tax of previousAmount by the PLR table" minimum(0)
+// @Param competence query string false "Competence month (YYYY-MM) of the payment whose PLR table is applied, defaults to the current month"
+// @Produce  json
+// @Success 200 {object} controllers.ProfitSharingResponse "PLR with the IRRF withheld"
+// @Failure 400 {object} controllers.Error "Invalid fields provided"
+// @Failure 500 {object} controllers.Error "Tax tables could not be loaded"
+// @Router /payroll/profit-sharing [get]
+func GetProfitSharing(c *gin.Context) {
+	amount, err1 := parseFiniteFloat(c.Query("amount"))
+	previousAmount, err2 := parseOptionalFloat(c, "previousAmount", 0)
+	if err1 != nil || err2 != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: "Campos inválidos"})
+		return
+	}
+
+	if amount < 0 || previousAmount < 0 {
+		c.JSON(http.StatusBadRequest, Error{Message: "Valores da PLR não podem ser negativos"})
+		return
+	}
+
+	competence, err := parseCompetenceParam(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	config, ok := activeTaxConfig(c, competence)
+	if !ok {
+		return
+	}
+
+	if len(config.PLRRanges) == 0 {
+		c.JSON(http.StatusBadRequest, Error{Message: fmt.Sprintf("Tabela de PLR não configurada para a competência %s", competence)})
+		return
+	}
+
+	previous := decimal.NewFromFloat(previousAmount)
+	previousWithheld, err := parseOptionalDecimal(c, "previousWithheld", models.ProfitSharingTax(previous, config))
+	if err != nil || previousWithheld.IsNegative() {
+		c.JSON(http.StatusBadRequest, Error{Message: "IRRF retido anteriormente inválido"})
+		return
+	}
+
+	plr := models.NewProfitSharing(decimal.NewFromFloat(amount), previous, previousWithheld, config)
+	c.JSON(http.StatusOK, NewProfitSharingResponse(plr, competence))
+}
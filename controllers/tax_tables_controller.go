@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/emvnuel/payroll/models"
+	"github.com/gin-gonic/gin"
+)
+
+type TaxTablesController struct {
+	store models.TaxRulesStore
+}
+
+type TaxTablesSummaryResponse struct {
+	Version string                   `json:"version"`
+	Active  bool                     `json:"active"`
+	Tables  []TaxTablePeriodResponse `json:"tables"`
+}
+
+type TaxTablePeriodResponse struct {
+	ValidFrom  string `json:"validFrom"`
+	ValidUntil string `json:"validUntil,omitempty"`
+}
+
+func NewTaxTablesController(store models.TaxRulesStore) *TaxTablesController {
+	return &TaxTablesController{store: store}
+}
+
+func NewTaxTablesSummaryResponse(rules *models.TaxRules) *TaxTablesSummaryResponse {
+	periods := make([]TaxTablePeriodResponse, len(rules.Tables))
+	for i, table := range rules.Tables {
+		periods[i] = TaxTablePeriodResponse{ValidFrom: table.ValidFrom.String()}
+		if table.ValidUntil != nil {
+			periods[i].ValidUntil = table.ValidUntil.String()
+		}
+	}
+
+	return &TaxTablesSummaryResponse{
+		Version: rules.Version,
+		Active:  rules.Version == models.CurrentTaxRules().Version,
+		Tables:  periods,
+	}
+}
+
+// @Summary List tax table versions
+// @Description Lists the published tax rules versions and which one is active.
+// @Tags tax-tables
+// @Security BearerAuth
+// @Produce  json
+// @Success 200 {array} controllers.TaxTablesSummaryResponse "Published versions"
+// @Failure 401 {object} controllers.Error "Missing or invalid token"
+// @Router /tax-tables [get]
+func (tc *TaxTablesController) List(c *gin.Context) {
+	versions, err := tc.store.List()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, Error{Message: err.Error()})
+		return
+	}
+
+	response := make([]*TaxTablesSummaryResponse, len(versions))
+	for i, rules := range versions {
+		response[i] = NewTaxTablesSummaryResponse(rules)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get a tax table version
+// @Description Returns the full tax rules document of a published version, in the same format accepted by POST /tax-tables.
+// @Tags tax-tables
+// @Security BearerAuth
+// @Param version path string true "Tax rules version"
+// @Produce  json
+// @Success 200 {object} models.TaxRules "Tax rules document"
+// @Failure 401 {object} controllers.Error "Missing or invalid token"
+// @Failure 404 {object} controllers.Error "Version not found"
+// @Router /tax-tables/{version} [get]
+func (tc *TaxTablesController) Get(c *gin.Context) {
+	rules, err := tc.store.Get(c.Param("version"))
+	if err != nil {
+		respondStoreError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, rules)
+}
+
+// @Summary Publish a tax table version
+// @Description Validates and stores a new tax rules version. Publishing does not activate it.
+// @Tags tax-tables
+// @Security BearerAuth
+// @Accept  json
+// @Produce  json
+// @Param rules body models.TaxRules true "Tax rules document"
+// @Success 201 {object} controllers.TaxTablesSummaryResponse "Published version"
+// @Failure 400 {object} controllers.Error "Invalid tax rules"
+// @Failure 401 {object} controllers.Error "Missing or invalid token"
+// @Failure 409 {object} controllers.Error "Version already exists"
+// @Router /tax-tables [post]
+func (tc *TaxTablesController) Create(c *gin.Context) {
+	var rules models.TaxRules
+	if err := c.ShouldBindJSON(&rules); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: "Documento de tabelas inválido: " + err.Error()})
+		return
+	}
+	if rules.Version == "" {
+		c.JSON(http.StatusBadRequest, Error{Message: "Versão das tabelas é obrigatória"})
+		return
+	}
+	if err := rules.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: "Tabelas inconsistentes: " + err.Error()})
+		return
+	}
+
+	if err := tc.store.Save(&rules); err != nil {
+		respondStoreError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, NewTaxTablesSummaryResponse(&rules))
+}
+
+// @Summary Activate a tax table version
+// @Description Validates a published version again and makes it the one used by payroll calculations. The activated version is saved in the store and stays active across tax rules reloads and restarts.
+// @Tags tax-tables
+// @Security BearerAuth
+// @Param version path string true "Tax rules version"
+// @Produce  json
+// @Success 200 {object} controllers.TaxTablesSummaryResponse "Activated version"
+// @Failure 401 {object} controllers.Error "Missing or invalid token"
+// @Failure 404 {object} controllers.Error "Version not found"
+// @Failure 422 {object} controllers.Error "Version failed validation"
+// @Failure 500 {object} controllers.Error "Activated version could not be saved"
+// @Router /tax-tables/{version}/activate [put]
+func (tc *TaxTablesController) Activate(c *gin.Context) {
+	rules, err := models.ActivateTaxRules(tc.store, c.Param("version"))
+	if errors.Is(err, models.ErrTaxRulesInvalid) {
+		c.JSON(http.StatusUnprocessableEntity, Error{Message: "Tabelas inconsistentes: " + err.Error()})
+		return
+	}
+	if err != nil {
+		respondStoreError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, NewTaxTablesSummaryResponse(rules))
+}
+
+func respondStoreError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrTaxRulesVersionNotFound):
+		c.JSON(http.StatusNotFound, Error{Message: "Versão de tabelas não encontrada"})
+	case errors.Is(err, models.ErrTaxRulesVersionExists):
+		c.JSON(http.StatusConflict, Error{Message: "Versão de tabelas já publicada"})
+	default:
+		c.JSON(http.StatusInternalServerError, Error{Message: err.Error()})
+	}
+}
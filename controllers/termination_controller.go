@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/emvnuel/payroll/models"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+type TerminationResponse struct {
+	Competence       string           `json:"competence"`
+	TaxRulesVersion  string           `json:"taxRulesVersion"`
+	TerminationType  string           `json:"terminationType"`
+	Description      string           `json:"description"`
+	NoticeDays       int              `json:"noticeDays"`
+	ProjectedEndDate string           `json:"projectedEndDate"`
+	GrossPay         float64          `json:"grossPay"`
+	TotalDiscount    float64          `json:"totalDiscount"`
+	NetPay           float64          `json:"netPay"`
+	FGTSDeposit      float64          `json:"fgtsDeposit"`
+	FGTSFine         float64          `json:"fgtsFine"`
+	Settlement       *PayrollResponse `json:"settlement"`
+	ThirteenthSalary *PayrollResponse `json:"thirteenthSalary,omitempty"`
+}
+
+func NewTerminationResponse(s *models.TerminationSettlement, competence models.Competence) *TerminationResponse {
+	response := &TerminationResponse{
+		Competence:       competence.String(),
+		TaxRulesVersion:  s.Settlement.TaxConfig.Version,
+		TerminationType:  string(s.Termination.Type),
+		Description:      s.Termination.Type.String(),
+		NoticeDays:       s.NoticeDays,
+		ProjectedEndDate: s.Termination.ProjectedEnd().Format(time.DateOnly),
+		GrossPay:         s.GrossPay().RoundBank(2).InexactFloat64(),
+		TotalDiscount:    s.TotalDiscount().RoundBank(2).InexactFloat64(),
+		NetPay:           s.NetPay().RoundBank(2).InexactFloat64(),
+		FGTSDeposit:      s.FGTSDeposit.RoundBank(2).InexactFloat64(),
+		FGTSFine:         s.FGTSFine.RoundBank(2).InexactFloat64(),
+		Settlement:       NewPayrollResponse(s.Settlement, competence),
+	}
+	if s.ThirteenthSalary != nil {
+		response.ThirteenthSalary = NewPayrollResponse(s.ThirteenthSalary, competence)
+	}
+	return response
+}
+
+// @Summary Calculate Termination Settlement
+// @Description Calculates the termination settlement (TRCT) for the termination type: salary balance, indemnified notice period of 30 days plus 3 per year of service, expired and proportional vacation with one-third, proportional 13th salary and the FGTS fine. The 13th salary has its own INSS and IRRF. The tax tables are the ones of the termination month.
+// @Tags payroll
+// @Param terminationType query string true "Termination type" Enums(without_cause, resignation, for_cause, mutual_agreement, fixed_term_end)
+// @Param grossPay query number true "Last monthly salary of the employee" minimum(0)
+// @Param variableAverage query number false "Average of variable earnings in the last 12 months" minimum(0)
+// @Param numberOfDependents query integer true "Number of dependents of the employee" minimum(0)
+// @Param admissionDate query string true "Admission date (YYYY-MM-DD)"
+// @Param terminationDate query string true "Termination date (YYYY-MM-DD)"
+// @Param expiredVacations query integer false "Complete vacation periods not taken, defaults to 0" minimum(0) maximum(2)
+// @Param fgtsBalance query number false "FGTS account balance for the fine, defaults to 0" minimum(0)
+// @Produce  json
+// @Success 200 {object} controllers.TerminationResponse "Termination settlement"
+// @Failure 400 {object} controllers.Error "Invalid fields provided"
+// @Failure 500 {object} controllers.Error "Tax tables could not be loaded"
+// @Router /payroll/termination [get]
+func GetTermination(c *gin.Context) {
+	termination, numberOfDependents, err := parseTerminationParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	competence := models.CompetenceOf(termination.Date)
+	config, ok := activeTaxConfig(c, competence)
+	if !ok {
+		return
+	}
+
+	settlement, err := models.NewTerminationSettlement(termination, int64(numberOfDependents), config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: "Dados da rescisão inválidos"})
+		return
+	}
+
+	c.JSON(http.StatusOK, NewTerminationResponse(settlement, competence))
+}
+
+func parseTerminationParams(c *gin.Context) (models.Termination, int, error) {
+	monthlySalary, err1 := parseFiniteFloat(c.Query("grossPay"))
+	variableAverage, err2 := parseOptionalFloat(c, "variableAverage", 0)
+	expiredVacations, err3 := parseOptionalInt(c, "expiredVacations", 0)
+	fgtsBalance, err4 := parseOptionalFloat(c, "fgtsBalance", 0)
+
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+		return models.Termination{}, 0, &Error{Message: "Campos inválidos"}
+	}
+
+	numberOfDependents, err := parseNumberOfDependents(c)
+	if err != nil {
+		return models.Termination{}, 0, err
+	}
+
+	terminationType, err := models.ParseTerminationType(c.Query("terminationType"))
+	if err != nil {
+		return models.Termination{}, 0, &Error{Message: "Tipo de rescisão inválido, use without_cause, resignation, for_cause, mutual_agreement ou fixed_term_end"}
+	}
+
+	admission, err1 := time.Parse(time.DateOnly, c.Query("admissionDate"))
+	termination, err2 := time.Parse(time.DateOnly, c.Query("terminationDate"))
+	if err1 != nil || err2 != nil {
+		return models.Termination{}, 0, &Error{Message: "Datas de admissão e desligamento são obrigatórias no formato AAAA-MM-DD"}
+	}
+
+	params := models.Termination{
+		Type:             terminationType,
+		Admission:        admission,
+		Date:             termination,
+		MonthlySalary:    decimal.NewFromFloat(monthlySalary),
+		VariableAverage:  decimal.NewFromFloat(variableAverage),
+		ExpiredVacations: expiredVacations,
+		FGTSBalance:      decimal.NewFromFloat(fgtsBalance),
+	}
+	if err := params.Validate(); err != nil {
+		return models.Termination{}, 0, &Error{Message: "Valores não podem ser negativos, o desligamento não pode ser anterior à admissão e as férias vencidas vão até 2 períodos"}
+	}
+	return params, numberOfDependents, nil
+}
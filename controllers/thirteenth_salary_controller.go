@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/emvnuel/payroll/models"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+type ThirteenthSalaryResponse struct {
+	Competence        string           `json:"competence"`
+	TaxRulesVersion   string           `json:"taxRulesVersion"`
+	Avos              int              `json:"avos"`
+	Total             float64          `json:"total"`
+	FirstInstallment  float64          `json:"firstInstallment"`
+	SecondInstallment *PayrollResponse `json:"secondInstallment"`
+}
+
+func NewThirteenthSalaryResponse(t *models.ThirteenthSalary, competence models.Competence) *ThirteenthSalaryResponse {
+	return &ThirteenthSalaryResponse{
+		Competence:        competence.String(),
+		TaxRulesVersion:   t.SecondInstallment.TaxConfig.Version,
+		Avos:              int(t.Avos),
+		Total:             t.Total().RoundBank(2).InexactFloat64(),
+		FirstInstallment:  t.FirstInstallment.RoundBank(2).InexactFloat64(),
+		SecondInstallment: NewPayrollResponse(t.SecondInstallment, competence),
+	}
+}
+
+// @Summary Calculate Thirteenth Salary
+// @Description Calculates the 13th salary in two installments. The first one is half of the amount without taxes. The second one withholds INSS on the full 13th and IRRF under exclusive taxation, apart from the monthly salary, and deducts the first installment. The tax tables are the ones of December of the year.
+// @Tags payroll
+// @Param grossPay query number true "Monthly salary of the employee" minimum(0)
+// @Param variableAverage query number false "Yearly average of variable earnings (overtime, commissions, premiums)" minimum(0)
+// @Param numberOfDependents query integer true "Number of dependents of the employee" minimum(0)
+// @Param year query integer false "Year of the 13th salary, defaults to the current year"
+// @Param avos query integer false "Months worked with 15 days or more, defaults to the count from admissionDate and terminationDate or 12" minimum(0) maximum(12)
+// @Param admissionDate query string false "Admission date (YYYY-MM-DD), used to count the avos"
+// @Param terminationDate query string false "Termination date (YYYY-MM-DD), used to count the avos"
+// @Produce  json
+// @Success 200 {object} controllers.ThirteenthSalaryResponse "Both installments of the 13th salary"
+// @Failure 400 {object} controllers.Error "Invalid fields provided"
+// @Failure 500 {object} controllers.Error "Tax tables could not be loaded"
+// @Router /payroll/thirteenth-salary [get]
+func GetThirteenthSalary(c *gin.Context) {
+	params, err := parseThirteenthSalaryParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	config, ok := activeTaxConfig(c, params.competence)
+	if !ok {
+		return
+	}
+
+	thirteenth := models.NewThirteenthSalary(
+		decimal.NewFromFloat(params.monthlySalary),
+		decimal.NewFromFloat(params.variableAverage),
+		params.avos,
+		int64(params.numberOfDependents),
+		config,
+	)
+
+	c.JSON(http.StatusOK, NewThirteenthSalaryResponse(thirteenth, params.competence))
+}
+
+type thirteenthSalaryParams struct {
+	monthlySalary      float64
+	variableAverage    float64
+	numberOfDependents int
+	avos               models.Avos
+	// competence é dezembro do ano do 13º
+	competence models.Competence
+}
+
+func parseThirteenthSalaryParams(c *gin.Context) (*thirteenthSalaryParams, error) {
+	monthlySalary, err1 := parseFiniteFloat(c.Query("grossPay"))
+	variableAverage, err2 := parseOptionalFloat(c, "variableAverage", 0)
+	year, err3 := parseOptionalInt(c, "year", time.Now().Year())
+
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil, &Error{Message: "Campos inválidos"}
+	}
+
+	if monthlySalary < 0 || variableAverage < 0 {
+		return nil, &Error{Message: "Salário e médias não podem ser negativos"}
+	}
+
+	numberOfDependents, err := parseNumberOfDependents(c)
+	if err != nil {
+		return nil, err
+	}
+
+	admission, err1 := parseOptionalDate(c, "admissionDate")
+	termination, err2 := parseOptionalDate(c, "terminationDate")
+
+	if err1 != nil || err2 != nil {
+		return nil, &Error{Message: "Data inválida, use o formato AAAA-MM-DD"}
+	}
+
+	avos, err := parseOptionalInt(c, "avos", int(models.ThirteenthSalaryAvos(year, admission, termination)))
+	if err != nil {
+		return nil, &Error{Message: "Campos inválidos"}
+	}
+
+	if avos < 0 || avos > 12 {
+		return nil, &Error{Message: "Avos devem ser entre 0 e 12"}
+	}
+
+	return &thirteenthSalaryParams{
+		monthlySalary:      monthlySalary,
+		variableAverage:    variableAverage,
+		numberOfDependents: numberOfDependents,
+		avos:               models.Avos(avos),
+		competence:         models.NewCompetence(year, time.December),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/emvnuel/payroll/models"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+type VacationResponse struct {
+	*PayrollResponse
+	VacationDays int `json:"vacationDays"`
+	SoldDays     int `json:"soldDays"`
+}
+
+func NewVacationResponse(v *models.Vacation, competence models.Competence) *VacationResponse {
+	return &VacationResponse{
+		PayrollResponse: NewPayrollResponse(v.Receipt, competence),
+		VacationDays:    v.Period.Days,
+		SoldDays:        v.Period.SoldDays,
+	}
+}
+
+// @Summary Calculate Vacation Pay
+// @Description Calculates the vacation receipt: the vacation days with the constitutional one-third bonus, subject to INSS, IRRF and FGTS, and the sold days (abono pecuniário) with their one-third, which are exempt. INSS and IRRF are withheld on the receipt apart from the monthly payroll.
+// @Tags payroll
+// @Param grossPay query number true "Monthly salary of the employee" minimum(0)
+// @Param variableAverage query number false "Average of variable earnings in the last 12 months (overtime, commissions, premiums)" minimum(0)
+// @Param numberOfDependents query integer true "Number of dependents of the employee" minimum(0)
+// @Param vacationDays query integer false "Vacation days taken, defaults to 30 minus soldDays" minimum(5) maximum(30)
+// @Param soldDays query integer false "Vacation days sold as abono pecuniário, defaults to 0" minimum(0) maximum(10)
+// @Param competence query string false "Competence month (YYYY-MM) whose tax tables are applied, defaults to the current month"
+// @Produce  json
+// @Success 200 {object} controllers.VacationResponse "Vacation receipt"
+// @Failure 400 {object} controllers.Error "Invalid fields provided"
+// @Failure 500 {object} controllers.Error "Tax tables could not be loaded"
+// @Router /payroll/vacation [get]
+func GetVacation(c *gin.Context) {
+	params, err := parseVacationParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	config, ok := activeTaxConfig(c, params.competence)
+	if !ok {
+		return
+	}
+
+	vacation := models.NewVacation(
+		decimal.NewFromFloat(params.monthlySalary),
+		decimal.NewFromFloat(params.variableAverage),
+		params.period,
+		int64(params.numberOfDependents),
+		config,
+	)
+
+	c.JSON(http.StatusOK, NewVacationResponse(vacation, params.competence))
+}
+
+type vacationParams struct {
+	monthlySalary      float64
+	variableAverage    float64
+	numberOfDependents int
+	period             models.VacationPeriod
+	competence         models.Competence
+}
+
+func parseVacationParams(c *gin.Context) (*vacationParams, error) {
+	monthlySalary, err1 := parseFiniteFloat(c.Query("grossPay"))
+	variableAverage, err2 := parseOptionalFloat(c, "variableAverage", 0)
+	soldDays, err3 := parseOptionalInt(c, "soldDays", 0)
+
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil, &Error{Message: "Campos inválidos"}
+	}
+
+	vacationDays, err := parseOptionalInt(c, "vacationDays", models.DefaultVacationDays(soldDays))
+	if err != nil {
+		return nil, &Error{Message: "Campos inválidos"}
+	}
+
+	if monthlySalary < 0 || variableAverage < 0 {
+		return nil, &Error{Message: "Salário e médias não podem ser negativos"}
+	}
+
+	numberOfDependents, err := parseNumberOfDependents(c)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := models.NewVacationPeriod(vacationDays, soldDays)
+	if err != nil {
+		return nil, &Error{Message: "Férias devem ter ao menos 5 dias e o abono até 10 dias, sem passar de 30 dias no total"}
+	}
+
+	competence, err := parseCompetenceParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vacationParams{
+		monthlySalary:      monthlySalary,
+		variableAverage:    variableAverage,
+		numberOfDependents: numberOfDependents,
+		period:             period,
+		competence:         competence,
+	}, nil
+}
@@ -26,7 +26,7 @@ const docTemplate = `{
     "paths": {
         "/payroll": {
             "get": {
-                "description": "This endpoint calculates the net pay based on gross pay, number of dependents, and applied discounts.",
+                "description": "This endpoint calculates the net pay based on gross pay, number of dependents, and applied discounts. The IRRF calculation automatically uses the most favorable method (simplified deduction vs dependent deduction).",
                 "produces": [
                     "application/json"
                 ],
@@ -35,12 +35,57 @@ const docTemplate = `{
                 ],
                 "summary": "Calculate Payroll",
                 "parameters": [
+                    {
+                        "enum": [
+                            "monthly",
+                            "hourly"
+                        ],
+                        "type": "string",
+                        "description": "Pay mode, monthly salary or hourly worker (horista), defaults to monthly",
+                        "name": "payMode",
+                        "in": "query"
+                    },
                     {
                         "type": "number",
-                        "description": "Gross pay of the employee",
+                        "description": "Monthly salary of the employee, required in the monthly pay mode",
                         "name": "grossPay",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admission date (YYYY-MM-DD) within the competence in the monthly pay mode, prorates the monthly salary and the unhealthy work premium",
+                        "name": "admissionDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Termination date (YYYY-MM-DD) within the competence in the monthly pay mode, prorates the monthly salary and the unhealthy work premium",
+                        "name": "terminationDate",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "commercial",
+                            "calendar"
+                        ],
+                        "type": "string",
+                        "description": "How days are counted in the prorated salary, defaults to the commercial 30-day month",
+                        "name": "prorationConvention",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Hourly rate, required in the hourly pay mode",
+                        "name": "hourlyRate",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Hours worked in the month, required in the hourly pay mode",
+                        "name": "hoursWorked",
+                        "in": "query"
                     },
                     {
                         "minimum": 0,
@@ -50,6 +95,13 @@ const docTemplate = `{
                         "in": "query",
                         "required": true
                     },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Children under 14 or disabled entitled to the family allowance (salário-família)",
+                        "name": "familyAllowanceChildren",
+                        "in": "query"
+                    },
                     {
                         "minimum": 0,
                         "type": "number",
@@ -67,13 +119,141 @@ const docTemplate = `{
                         "in": "query",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "Competence month (YYYY-MM) whose tax tables are applied, defaults to the current month",
+                        "name": "competence",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Contractual monthly hours used to compute the hourly rate in the monthly pay mode, defaults to 220",
+                        "name": "monthlyHours",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Overtime hours paid with a 50% premium",
+                        "name": "overtimeHours50",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Overtime hours on Sundays and holidays paid with a 100% premium",
+                        "name": "overtimeHours100",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Business days in the month used in the DSR reflection, defaults to the days of the competence except Sundays",
+                        "name": "businessDays",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Rest days (Sundays and holidays) in the month used in the DSR reflection, defaults to the Sundays of the competence",
+                        "name": "restDays",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Clock hours worked between 22h and 5h, converted using the 52m30s night hour",
+                        "name": "nightHours",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1,
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Night shift premium (between 0 and 1), defaults to 0.2",
+                        "name": "nightPremium",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
-                        "default": false,
-                        "description": "Simplified deduction",
-                        "name": "simplifiedDeduction",
-                        "in": "query",
-                        "required": true
+                        "description": "Whether the employee is entitled to the 30% hazard premium (periculosidade)",
+                        "name": "hazardous",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "minimum",
+                            "medium",
+                            "maximum"
+                        ],
+                        "type": "string",
+                        "description": "Unhealthy work grade (insalubridade)",
+                        "name": "insalubrityGrade",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Minimum wage reference for the unhealthy work premium, defaults to the one in the competence tax table",
+                        "name": "minimumWage",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Commissions earned in the month, with DSR computed from the business and rest days",
+                        "name": "commission",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Travel per diems (diárias), not subject to INSS, IRRF and FGTS by default",
+                        "name": "perDiem",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Relocation allowance (ajuda de custo), not subject to INSS, IRRF and FGTS by default",
+                        "name": "relocationAllowance",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Expense reimbursements, not subject to INSS, IRRF and FGTS by default",
+                        "name": "reimbursement",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Performance bonuses (prêmios), subject only to IRRF by default",
+                        "name": "bonus",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Unjustified absence days in the monthly pay mode, deducted from the INSS and IRRF bases",
+                        "name": "absenceDays",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Rest days lost in weeks with unjustified absences, deducted by the daily rate or, in the hourly pay mode, by the DSR of one rest day",
+                        "name": "lostRestDays",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Late arrival hours in the monthly pay mode, deducted from the INSS and IRRF bases",
+                        "name": "lateHours",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -88,50 +268,1252 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controllers.Error"
                         }
+                    },
+                    "500": {
+                        "description": "Tax tables could not be loaded",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controllers.DiscountResponse": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "number"
-                }
-            }
         },
-        "controllers.Error": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
+        "/payroll/compare": {
+            "get": {
+                "description": "Calculates the same payroll under two tax table versions and/or competences and returns both results with the per-discount differences (target minus base). Business days, rest days and the prorated salary are computed for each side's competence.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Compare Payroll",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Gross pay of the employee. Accepts the same earning and discount parameters as /payroll",
+                        "name": "grossPay",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Number of dependents of the employee",
+                        "name": "numberOfDependents",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Value of the fixed amount discount",
+                        "name": "fixedAmountDiscount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 1,
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Percentage discount value (between 0 and 1)",
+                        "name": "percentangeDiscount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Competence month (YYYY-MM) used when baseCompetence or targetCompetence are omitted, defaults to the current month",
+                        "name": "competence",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Competence month (YYYY-MM) of the base calculation",
+                        "name": "baseCompetence",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Competence month (YYYY-MM) of the target calculation",
+                        "name": "targetCompetence",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tax rules version of the base calculation, defaults to the active version",
+                        "name": "baseVersion",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tax rules version of the target calculation, defaults to the active version",
+                        "name": "targetVersion",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Both payrolls and their differences",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.PayrollComparisonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid fields provided",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Tax rules version not found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Tax tables could not be loaded",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
                 }
             }
         },
-        "controllers.PayrollResponse": {
-            "type": "object",
-            "properties": {
-                "discounts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/controllers.DiscountResponse"
+        "/payroll/pro-labore": {
+            "get": {
+                "description": "Calculates the pro-labore of a company partner: INSS of 11% up to the ceiling as contribuinte individual, IRRF by the monthly table and no FGTS. Also returns the 20% employer contribution paid by the company.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Calculate Pro-labore",
+                "parameters": [
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Pro-labore amount",
+                        "name": "grossPay",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Number of dependents of the partner",
+                        "name": "numberOfDependents",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Competence month (YYYY-MM) whose tax tables are applied, defaults to the current month",
+                        "name": "competence",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pro-labore with the employer contribution",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProLaboreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid fields provided",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Tax tables could not be loaded",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
                     }
-                },
-                "grossPay": {
-                    "type": "number"
-                },
-                "netPay": {
-                    "type": "number"
-                },
-                "totalDiscount": {
-                    "type": "number"
                 }
             }
+        },
+        "/payroll/profit-sharing": {
+            "get": {
+                "description": "Calculates the IRRF withheld on a profit sharing (PLR) payment by the exclusive annual PLR table, without INSS. Payments in the same calendar year are added up and the tax already withheld on them is deducted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Calculate Profit Sharing",
+                "parameters": [
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "PLR amount paid now",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "PLR already paid in the calendar year, defaults to 0",
+                        "name": "previousAmount",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "IRRF withheld on the PLR already paid, defaults to the tax of previousAmount by the PLR table",
+                        "name": "previousWithheld",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Competence month (YYYY-MM) of the payment whose PLR table is applied, defaults to the current month",
+                        "name": "competence",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PLR with the IRRF withheld",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProfitSharingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid fields provided",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Tax tables could not be loaded",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll/termination": {
+            "get": {
+                "description": "Calculates the termination settlement (TRCT) for the termination type: salary balance, indemnified notice period of 30 days plus 3 per year of service, expired and proportional vacation with one-third, proportional 13th salary and the FGTS fine. The 13th salary has its own INSS and IRRF. The tax tables are the ones of the termination month.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Calculate Termination Settlement",
+                "parameters": [
+                    {
+                        "enum": [
+                            "without_cause",
+                            "resignation",
+                            "for_cause",
+                            "mutual_agreement",
+                            "fixed_term_end"
+                        ],
+                        "type": "string",
+                        "description": "Termination type",
+                        "name": "terminationType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Last monthly salary of the employee",
+                        "name": "grossPay",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Average of variable earnings in the last 12 months",
+                        "name": "variableAverage",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Number of dependents of the employee",
+                        "name": "numberOfDependents",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admission date (YYYY-MM-DD)",
+                        "name": "admissionDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Termination date (YYYY-MM-DD)",
+                        "name": "terminationDate",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 2,
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Complete vacation periods not taken, defaults to 0",
+                        "name": "expiredVacations",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "FGTS account balance for the fine, defaults to 0",
+                        "name": "fgtsBalance",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Termination settlement",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.TerminationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid fields provided",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Tax tables could not be loaded",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll/thirteenth-salary": {
+            "get": {
+                "description": "Calculates the 13th salary in two installments. The first one is half of the amount without taxes. The second one withholds INSS on the full 13th and IRRF under exclusive taxation, apart from the monthly salary, and deducts the first installment. The tax tables are the ones of December of the year.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Calculate Thirteenth Salary",
+                "parameters": [
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Monthly salary of the employee",
+                        "name": "grossPay",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Yearly average of variable earnings (overtime, commissions, premiums)",
+                        "name": "variableAverage",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Number of dependents of the employee",
+                        "name": "numberOfDependents",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Year of the 13th salary, defaults to the current year",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 12,
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Months worked with 15 days or more, defaults to the count from admissionDate and terminationDate or 12",
+                        "name": "avos",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admission date (YYYY-MM-DD), used to count the avos",
+                        "name": "admissionDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Termination date (YYYY-MM-DD), used to count the avos",
+                        "name": "terminationDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Both installments of the 13th salary",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ThirteenthSalaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid fields provided",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Tax tables could not be loaded",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/payroll/vacation": {
+            "get": {
+                "description": "Calculates the vacation receipt: the vacation days with the constitutional one-third bonus, subject to INSS, IRRF and FGTS, and the sold days (abono pecuniário) with their one-third, which are exempt. INSS and IRRF are withheld on the receipt apart from the monthly payroll.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "Calculate Vacation Pay",
+                "parameters": [
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Monthly salary of the employee",
+                        "name": "grossPay",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "Average of variable earnings in the last 12 months (overtime, commissions, premiums)",
+                        "name": "variableAverage",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Number of dependents of the employee",
+                        "name": "numberOfDependents",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 5,
+                        "type": "integer",
+                        "description": "Vacation days taken, defaults to 30 minus soldDays",
+                        "name": "vacationDays",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "Vacation days sold as abono pecuniário, defaults to 0",
+                        "name": "soldDays",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Competence month (YYYY-MM) whose tax tables are applied, defaults to the current month",
+                        "name": "competence",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Vacation receipt",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.VacationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid fields provided",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Tax tables could not be loaded",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/tax-tables": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the published tax rules versions and which one is active.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-tables"
+                ],
+                "summary": "List tax table versions",
+                "responses": {
+                    "200": {
+                        "description": "Published versions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.TaxTablesSummaryResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Validates and stores a new tax rules version. Publishing does not activate it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-tables"
+                ],
+                "summary": "Publish a tax table version",
+                "parameters": [
+                    {
+                        "description": "Tax rules document",
+                        "name": "rules",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TaxRules"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Published version",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.TaxTablesSummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tax rules",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Version already exists",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/tax-tables/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the full tax rules document of a published version, in the same format accepted by POST /tax-tables.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-tables"
+                ],
+                "summary": "Get a tax table version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tax rules version",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tax rules document",
+                        "schema": {
+                            "$ref": "#/definitions/models.TaxRules"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Version not found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/tax-tables/{version}/activate": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Validates a published version again and makes it the one used by payroll calculations. The activated version is saved in the store and stays active across tax rules reloads and restarts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tax-tables"
+                ],
+                "summary": "Activate a tax table version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tax rules version",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Activated version",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.TaxTablesSummaryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Version not found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "422": {
+                        "description": "Version failed validation",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Activated version could not be saved",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.Error"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controllers.DiscountDifferenceResponse": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "number"
+                },
+                "difference": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.DiscountResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.EarningResponse": {
+            "type": "object",
+            "properties": {
+                "incidence": {
+                    "$ref": "#/definitions/models.Incidence"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proration": {
+                    "$ref": "#/definitions/controllers.ProrationResponse"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.Error": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.PayrollComparisonResponse": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "$ref": "#/definitions/controllers.PayrollResponse"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.DiscountDifferenceResponse"
+                    }
+                },
+                "netPayDifference": {
+                    "type": "number"
+                },
+                "target": {
+                    "$ref": "#/definitions/controllers.PayrollResponse"
+                },
+                "totalDiscountDifference": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.PayrollResponse": {
+            "type": "object",
+            "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.EarningResponse"
+                    }
+                },
+                "competence": {
+                    "type": "string"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.DiscountResponse"
+                    }
+                },
+                "earnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.EarningResponse"
+                    }
+                },
+                "fgtsBase": {
+                    "type": "number"
+                },
+                "grossPay": {
+                    "type": "number"
+                },
+                "inssBase": {
+                    "type": "number"
+                },
+                "irrfBase": {
+                    "type": "number"
+                },
+                "netPay": {
+                    "type": "number"
+                },
+                "taxRulesVersion": {
+                    "type": "string"
+                },
+                "totalBenefits": {
+                    "type": "number"
+                },
+                "totalDiscount": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.ProLaboreResponse": {
+            "type": "object",
+            "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.EarningResponse"
+                    }
+                },
+                "competence": {
+                    "type": "string"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.DiscountResponse"
+                    }
+                },
+                "earnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.EarningResponse"
+                    }
+                },
+                "employerContribution": {
+                    "type": "number"
+                },
+                "fgtsBase": {
+                    "type": "number"
+                },
+                "grossPay": {
+                    "type": "number"
+                },
+                "inssBase": {
+                    "type": "number"
+                },
+                "irrfBase": {
+                    "type": "number"
+                },
+                "netPay": {
+                    "type": "number"
+                },
+                "taxRulesVersion": {
+                    "type": "string"
+                },
+                "totalBenefits": {
+                    "type": "number"
+                },
+                "totalCost": {
+                    "type": "number"
+                },
+                "totalDiscount": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.ProfitSharingResponse": {
+            "type": "object",
+            "properties": {
+                "accumulatedAmount": {
+                    "type": "number"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "competence": {
+                    "type": "string"
+                },
+                "irrf": {
+                    "type": "number"
+                },
+                "netPay": {
+                    "type": "number"
+                },
+                "previousAmount": {
+                    "type": "number"
+                },
+                "previousWithheld": {
+                    "type": "number"
+                },
+                "taxRulesVersion": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.ProrationResponse": {
+            "type": "object",
+            "properties": {
+                "daysWorked": {
+                    "type": "integer"
+                },
+                "monthDays": {
+                    "type": "integer"
+                },
+                "monthlyValue": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.TaxTablePeriodResponse": {
+            "type": "object",
+            "properties": {
+                "validFrom": {
+                    "type": "string"
+                },
+                "validUntil": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.TaxTablesSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.TaxTablePeriodResponse"
+                    }
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.TerminationResponse": {
+            "type": "object",
+            "properties": {
+                "competence": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "fgtsDeposit": {
+                    "type": "number"
+                },
+                "fgtsFine": {
+                    "type": "number"
+                },
+                "grossPay": {
+                    "type": "number"
+                },
+                "netPay": {
+                    "type": "number"
+                },
+                "noticeDays": {
+                    "type": "integer"
+                },
+                "projectedEndDate": {
+                    "type": "string"
+                },
+                "settlement": {
+                    "$ref": "#/definitions/controllers.PayrollResponse"
+                },
+                "taxRulesVersion": {
+                    "type": "string"
+                },
+                "terminationType": {
+                    "type": "string"
+                },
+                "thirteenthSalary": {
+                    "$ref": "#/definitions/controllers.PayrollResponse"
+                },
+                "totalDiscount": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.ThirteenthSalaryResponse": {
+            "type": "object",
+            "properties": {
+                "avos": {
+                    "type": "integer"
+                },
+                "competence": {
+                    "type": "string"
+                },
+                "firstInstallment": {
+                    "type": "number"
+                },
+                "secondInstallment": {
+                    "$ref": "#/definitions/controllers.PayrollResponse"
+                },
+                "taxRulesVersion": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "controllers.VacationResponse": {
+            "type": "object",
+            "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.EarningResponse"
+                    }
+                },
+                "competence": {
+                    "type": "string"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.DiscountResponse"
+                    }
+                },
+                "earnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.EarningResponse"
+                    }
+                },
+                "fgtsBase": {
+                    "type": "number"
+                },
+                "grossPay": {
+                    "type": "number"
+                },
+                "inssBase": {
+                    "type": "number"
+                },
+                "irrfBase": {
+                    "type": "number"
+                },
+                "netPay": {
+                    "type": "number"
+                },
+                "soldDays": {
+                    "type": "integer"
+                },
+                "taxRulesVersion": {
+                    "type": "string"
+                },
+                "totalBenefits": {
+                    "type": "number"
+                },
+                "totalDiscount": {
+                    "type": "number"
+                },
+                "vacationDays": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.FamilyAllowanceConfig": {
+            "type": "object",
+            "properties": {
+                "income_ceiling": {
+                    "type": "number"
+                },
+                "quota": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.INSSRange": {
+            "type": "object",
+            "properties": {
+                "aliquot": {
+                    "type": "number"
+                },
+                "end_value": {
+                    "type": "number"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "init_value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IRRFAdjustmentSpec": {
+            "type": "object",
+            "properties": {
+                "params": {
+                    "type": "object"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.IRRFRange": {
+            "type": "object",
+            "properties": {
+                "aliquot": {
+                    "type": "number"
+                },
+                "deduction": {
+                    "type": "number"
+                },
+                "end_value": {
+                    "type": "number"
+                },
+                "init_value": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IRRFReduction": {
+            "type": "object",
+            "properties": {
+                "constant": {
+                    "type": "number"
+                },
+                "max_amount": {
+                    "type": "number"
+                },
+                "multiplier": {
+                    "type": "number"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "upper_limit": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.Incidence": {
+            "type": "object",
+            "properties": {
+                "fgts": {
+                    "type": "boolean"
+                },
+                "inss": {
+                    "type": "boolean"
+                },
+                "irrf": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.TaxConfig": {
+            "type": "object",
+            "properties": {
+                "allowance_incidences": {
+                    "description": "AllowanceIncidences substitui, por tipo, a incidência padrão das verbas\nindenizatórias e dos prêmios",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.Incidence"
+                    }
+                },
+                "dependent_deduction": {
+                    "type": "number"
+                },
+                "family_allowance": {
+                    "description": "FamilyAllowance são os parâmetros do salário-família. Nulo indica que\no benefício não é calculado.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FamilyAllowanceConfig"
+                        }
+                    ]
+                },
+                "inss_flat_rate": {
+                    "description": "INSSFlatRate indica a regra anterior a março de 2020, em que a alíquota\nda faixa incide sobre todo o salário de contribuição",
+                    "type": "boolean"
+                },
+                "inss_ranges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.INSSRange"
+                    }
+                },
+                "irrf_adjustments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IRRFAdjustmentSpec"
+                    }
+                },
+                "irrf_ranges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IRRFRange"
+                    }
+                },
+                "irrf_reduction": {
+                    "description": "IRRFReduction é a forma abreviada de configurar o ajuste da Lei nº\n15.270/2025, aplicado antes dos demais ajustes",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.IRRFReduction"
+                        }
+                    ]
+                },
+                "minimum_wage": {
+                    "description": "MinimumWage é o salário mínimo nacional, referência do adicional de\ninsalubridade. Zero indica que não foi configurado.",
+                    "type": "number"
+                },
+                "plr_ranges": {
+                    "description": "PLRRanges é a tabela progressiva anual da participação nos lucros ou\nresultados, de tributação exclusiva (Lei nº 10.101/2000). Vazia indica\nque a PLR não é calculada.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IRRFRange"
+                    }
+                },
+                "simplified_deduction_percentage": {
+                    "type": "number"
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_until": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TaxRules": {
+            "type": "object",
+            "properties": {
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaxConfig"
+                    }
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
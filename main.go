@@ -1,8 +1,15 @@
 package main
 
 import (
+	"context"
+	"log"
+	"os"
+	"syscall"
+	"time"
+
 	"github.com/emvnuel/payroll/controllers"
 	_ "github.com/emvnuel/payroll/docs/payroll"
+	"github.com/emvnuel/payroll/models"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
@@ -23,11 +30,62 @@ import (
 
 // @BasePath /
 // @schemes http https
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
 func main() {
+	store := newTaxRulesStore()
+	reloader := models.NewTaxRulesReloader(models.NewTaxTableProviderFromEnv(), store)
+	if err := reloader.Reload(); err != nil {
+		log.Fatalf("Refusing to start with invalid tax rules: %v", err)
+	}
+	go reloader.WatchSignals(context.Background(), syscall.SIGHUP)
+	if path := os.Getenv("TAX_RULES_FILE"); path != "" {
+		go reloader.WatchFile(context.Background(), path, taxRulesWatchInterval())
+	}
+
 	r := gin.Default()
 	url := ginSwagger.URL("/swagger/doc.json") // The url pointing to API definition
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
 	r.GET("/payroll", controllers.GetPayroll)
+	r.GET("/payroll/compare", controllers.NewPayrollComparisonController(store).Compare)
+	r.GET("/payroll/thirteenth-salary", controllers.GetThirteenthSalary)
+	r.GET("/payroll/vacation", controllers.GetVacation)
+	r.GET("/payroll/termination", controllers.GetTermination)
+	r.GET("/payroll/profit-sharing", controllers.GetProfitSharing)
+	r.GET("/payroll/pro-labore", controllers.GetProLabore)
 	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	taxTables := controllers.NewTaxTablesController(store)
+	admin := r.Group("/tax-tables", controllers.AdminAuth(os.Getenv("ADMIN_API_TOKEN")))
+	admin.GET("", taxTables.List)
+	admin.GET("/:version", taxTables.Get)
+	admin.POST("", taxTables.Create)
+	admin.PUT("/:version/activate", taxTables.Activate)
+
 	r.Run() // listen and serve on 0.0.0.0:8080
 }
+
+// newTaxRulesStore guarda as versões publicadas e a versão ativada pela API
+// em TAX_RULES_STORE_DIR ou, sem ele, em memória, perdendo-as a cada reinício
+func newTaxRulesStore() models.TaxRulesStore {
+	dir := os.Getenv("TAX_RULES_STORE_DIR")
+	if dir == "" {
+		log.Print("TAX_RULES_STORE_DIR is not set, published tax rules and the activated version are kept in memory and lost on restart")
+		return models.NewMemoryTaxRulesStore()
+	}
+	store, err := models.NewFileTaxRulesStore(dir)
+	if err != nil {
+		log.Fatalf("Refusing to start without the tax rules store: %v", err)
+	}
+	return store
+}
+
+func taxRulesWatchInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("TAX_RULES_WATCH_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return 30 * time.Second
+	}
+	return interval
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// DailyRate é o valor do dia de um salário mensal, pelo mês comercial de 30 dias
+func DailyRate(monthlySalary decimal.Decimal) decimal.Decimal {
+	return monthlySalary.Div(decimal.NewFromInt(commercialMonthDays))
+}
+
+// AbsenceDiscount é o desconto das faltas injustificadas, pelo valor do dia
+type AbsenceDiscount struct {
+	dailyRate decimal.Decimal
+	days      decimal.Decimal
+}
+
+func NewAbsenceDiscount(dailyRate, days decimal.Decimal) *AbsenceDiscount {
+	return &AbsenceDiscount{
+		dailyRate: dailyRate,
+		days:      days,
+	}
+}
+
+func (a AbsenceDiscount) Value() decimal.Decimal {
+	return a.dailyRate.Mul(a.days).RoundBank(2)
+}
+
+func (a AbsenceDiscount) Name() string {
+	return fmt.Sprintf("Faltas (%s)", countLabel(a.days, "dia", "dias"))
+}
+
+func (a AbsenceDiscount) Incidence() Incidence {
+	return FullIncidence
+}
+
+// LostRestDiscount é a perda do descanso semanal remunerado das semanas em
+// que houve falta injustificada: um dia de descanso por semana
+type LostRestDiscount struct {
+	dailyRate decimal.Decimal
+	restDays  int
+}
+
+func NewLostRestDiscount(dailyRate decimal.Decimal, restDays int) *LostRestDiscount {
+	return &LostRestDiscount{
+		dailyRate: dailyRate,
+		restDays:  restDays,
+	}
+}
+
+func (l LostRestDiscount) Value() decimal.Decimal {
+	return l.dailyRate.Mul(decimal.NewFromInt(int64(l.restDays))).RoundBank(2)
+}
+
+func (l LostRestDiscount) Name() string {
+	return fmt.Sprintf("DSR sobre faltas (%s)", countLabel(decimal.NewFromInt(int64(l.restDays)), "dia", "dias"))
+}
+
+func (l LostRestDiscount) Incidence() Incidence {
+	return FullIncidence
+}
+
+// LateArrivalDiscount é o desconto dos atrasos, pelo valor da hora normal
+type LateArrivalDiscount struct {
+	hourlyRate decimal.Decimal
+	hours      decimal.Decimal
+}
+
+func NewLateArrivalDiscount(hourlyRate, hours decimal.Decimal) *LateArrivalDiscount {
+	return &LateArrivalDiscount{
+		hourlyRate: hourlyRate,
+		hours:      hours,
+	}
+}
+
+func (l LateArrivalDiscount) Value() decimal.Decimal {
+	return l.hourlyRate.Mul(l.hours).RoundBank(2)
+}
+
+func (l LateArrivalDiscount) Name() string {
+	return fmt.Sprintf("Atrasos (%sh)", l.hours)
+}
+
+func (l LateArrivalDiscount) Incidence() Incidence {
+	return FullIncidence
+}
+
+// AbsenceDiscounts monta os descontos de faltas, da perda do DSR e de
+// atrasos, omitindo os que não se aplicam
+func AbsenceDiscounts(dailyRate, hourlyRate, absenceDays decimal.Decimal, lostRestDays int, lateHours decimal.Decimal) []Discount {
+	var discounts []Discount
+	if absenceDays.IsPositive() {
+		discounts = append(discounts, NewAbsenceDiscount(dailyRate, absenceDays))
+	}
+	if lostRestDays > 0 {
+		discounts = append(discounts, NewLostRestDiscount(dailyRate, lostRestDays))
+	}
+	if lateHours.IsPositive() {
+		discounts = append(discounts, NewLateArrivalDiscount(hourlyRate, lateHours))
+	}
+	return discounts
+}
+
+// countLabel escreve uma quantidade com a unidade no singular ou no plural
+func countLabel(count decimal.Decimal, singular, plural string) string {
+	if count.Equal(one) {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%s %s", count, plural)
+}
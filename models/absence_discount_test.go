@@ -0,0 +1,100 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestAbsenceDiscounts testa faltas, perda do DSR e atrasos
+// Salário R$ 3.000,00: dia de R$ 100,00 e hora de R$ 13,64 (220 horas)
+func TestAbsenceDiscounts(t *testing.T) {
+	salary := decimal.NewFromFloat(3000.00)
+	discounts := AbsenceDiscounts(DailyRate(salary), HourlyRate(salary, decimal.NewFromInt(220)), decimal.NewFromInt(2), 2, decimal.NewFromFloat(1.5))
+
+	expected := []struct {
+		name  string
+		value float64
+	}{
+		{"Faltas (2 dias)", 200.00},
+		{"DSR sobre faltas (2 dias)", 200.00},
+		{"Atrasos (1.5h)", 20.45},
+	}
+	if len(discounts) != len(expected) {
+		t.Fatalf("Esperados %d descontos, obtidos %d", len(expected), len(discounts))
+	}
+	for i, e := range expected {
+		if discounts[i].Name() != e.name || !discounts[i].Value().Equal(decimal.NewFromFloat(e.value)) {
+			t.Errorf("Desconto %d: esperado %s de %.2f, obtido %s de %s", i, e.name, e.value, discounts[i].Name(), discounts[i].Value())
+		}
+	}
+}
+
+// TestPayroll_AbsencesReduceBases testa que as faltas reduzem as bases do INSS
+// e do IRRF, enquanto o valor fixo é descontado depois dos impostos
+func TestPayroll_AbsencesReduceBases(t *testing.T) {
+	salary := decimal.NewFromFloat(4500.00)
+	absences := AbsenceDiscounts(DailyRate(salary), decimal.Zero, decimal.NewFromInt(2), 1, decimal.Zero)
+	fixed := NewFixedAmountDiscount(decimal.NewFromFloat(50.00))
+
+	payroll := NewPayroll(salary, 0, newTaxConfig2025(), append(absences, fixed)...)
+
+	names := []string{"INSS", "IRRF", "Faltas (2 dias)", "DSR sobre faltas (1 dia)", "Valor fixo"}
+	for i, name := range names {
+		if payroll.Discounts[i].Name() != name {
+			t.Errorf("Desconto %d: esperado %s, obtido %s", i, name, payroll.Discounts[i].Name())
+		}
+	}
+
+	// 4.500,00 menos 3 dias de R$ 150,00
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"Base do INSS", payroll.INSSBase(), 4050.00},
+		{"Base do IRRF", payroll.IRRFBase(), 4050.00},
+		// 113,85 + 114,83 + 1.256,12 × 12%
+		{"INSS", payroll.Discounts[0].Value(), 379.41},
+		// (4.050,00 - 607,20) × 15% - 394,16
+		{"IRRF", payroll.Discounts[1].Value(), 122.26},
+		// 4.500,00 - 379,41 - 122,26 - 450,00 - 50,00
+		{"Líquido", payroll.NetPay(), 3498.33},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
+
+// TestPayroll_HourlyLostRest testa a perda do DSR do horista pelo valor de um
+// dia de descanso. R$ 10,00 por hora e 160 horas em um mês com 26 dias úteis e
+// 5 de descanso: DSR de R$ 1.600,00 / 26 × 5 = R$ 307,69, ou R$ 61,54 por dia.
+func TestPayroll_HourlyLostRest(t *testing.T) {
+	month := WorkMonth{BusinessDays: 26, RestDays: 5}
+	earnings := HourlyPayEarnings(decimal.NewFromInt(10), decimal.NewFromInt(160), month)
+	restDayRate := RestDayRate(earnings[1].Value(), month)
+
+	payroll := NewPayrollFromEarnings(earnings, 0, newTaxConfig2026(), AbsenceDiscounts(restDayRate, decimal.Zero, decimal.Zero, 1, decimal.Zero)...)
+
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"DSR", earnings[1].Value(), 307.69},
+		{"DSR sobre faltas", payroll.Discounts[2].Value(), 61.54},
+		{"Base do INSS", payroll.INSSBase(), 1846.15},
+		// 121,57 + 225,15 × 9%
+		{"INSS", payroll.Discounts[0].Value(), 141.83},
+		{"IRRF", payroll.Discounts[1].Value(), 0.00},
+		// 1.907,69 - 141,83 - 61,54
+		{"Líquido", payroll.NetPay(), 1704.32},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
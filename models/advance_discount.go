@@ -0,0 +1,25 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// AdvanceDiscount é a compensação de um valor já adiantado ao empregado,
+// descontado depois dos impostos
+type AdvanceDiscount struct {
+	name   string
+	amount decimal.Decimal
+}
+
+func NewAdvanceDiscount(name string, amount decimal.Decimal) *AdvanceDiscount {
+	return &AdvanceDiscount{
+		name:   name,
+		amount: amount,
+	}
+}
+
+func (ad AdvanceDiscount) Value() decimal.Decimal {
+	return ad.amount.RoundBank(2)
+}
+
+func (ad AdvanceDiscount) Name() string {
+	return ad.name
+}
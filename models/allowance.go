@@ -0,0 +1,80 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// AllowanceType é o tipo de verba indenizatória ou de prêmio paga junto com o salário
+type AllowanceType string
+
+const (
+	// AllowancePerDiem são as diárias para viagem
+	AllowancePerDiem AllowanceType = "per_diem"
+	// AllowanceRelocation é a ajuda de custo
+	AllowanceRelocation AllowanceType = "relocation"
+	// AllowanceReimbursement é o reembolso de despesas
+	AllowanceReimbursement AllowanceType = "reimbursement"
+	// AllowanceBonus são os prêmios por desempenho
+	AllowanceBonus AllowanceType = "bonus"
+)
+
+var allowanceNames = map[AllowanceType]string{
+	AllowancePerDiem:       "Diárias de viagem",
+	AllowanceRelocation:    "Ajuda de custo",
+	AllowanceReimbursement: "Reembolso de despesas",
+	AllowanceBonus:         "Prêmios",
+}
+
+// defaultAllowanceIncidences segue o art. 457, § 2º, da CLT (Lei nº
+// 13.467/2017): nenhum dos tipos integra a remuneração para INSS e FGTS. Os
+// prêmios continuam tributáveis pelo IRRF.
+var defaultAllowanceIncidences = map[AllowanceType]Incidence{
+	AllowancePerDiem:       {},
+	AllowanceRelocation:    {},
+	AllowanceReimbursement: {},
+	AllowanceBonus:         {IRRF: true},
+}
+
+func ParseAllowanceType(value string) (AllowanceType, error) {
+	allowanceType := AllowanceType(value)
+	if _, ok := allowanceNames[allowanceType]; !ok {
+		return "", fmt.Errorf("invalid allowance type %q", value)
+	}
+	return allowanceType, nil
+}
+
+// AllowanceTypes retorna os tipos de verba em uma ordem fixa
+func AllowanceTypes() []AllowanceType {
+	return []AllowanceType{AllowancePerDiem, AllowanceRelocation, AllowanceReimbursement, AllowanceBonus}
+}
+
+// Allowance é uma verba indenizatória ou um prêmio, com a incidência definida
+// pelo tipo. Aumenta o bruto e o líquido, mas só entra nas bases dos tributos
+// indicados na incidência.
+type Allowance struct {
+	allowanceType AllowanceType
+	amount        decimal.Decimal
+	incidence     Incidence
+}
+
+func NewAllowance(allowanceType AllowanceType, amount decimal.Decimal, incidence Incidence) *Allowance {
+	return &Allowance{
+		allowanceType: allowanceType,
+		amount:        amount,
+		incidence:     incidence,
+	}
+}
+
+func (a Allowance) Value() decimal.Decimal {
+	return a.amount.RoundBank(2)
+}
+
+func (a Allowance) Name() string {
+	return allowanceNames[a.allowanceType]
+}
+
+func (a Allowance) Incidence() Incidence {
+	return a.incidence
+}
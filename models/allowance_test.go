@@ -0,0 +1,54 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestPayroll_AllowancesOutsideBases testa que as verbas indenizatórias
+// aumentam o bruto e o líquido sem alterar as bases do INSS e do IRRF
+func TestPayroll_AllowancesOutsideBases(t *testing.T) {
+	config := newTaxConfig2025()
+	salary := decimal.NewFromFloat(4000.00)
+	earnings := []Earning{NewBaseSalary(salary)}
+	for _, allowanceType := range []AllowanceType{AllowancePerDiem, AllowanceRelocation, AllowanceReimbursement} {
+		earnings = append(earnings, NewAllowance(allowanceType, decimal.NewFromFloat(200.00), config.AllowanceIncidence(allowanceType)))
+	}
+
+	payroll := NewPayrollFromEarnings(earnings, 0, config)
+
+	if !payroll.GrossPay.Equal(decimal.NewFromFloat(4600.00)) {
+		t.Errorf("Bruto esperado 4600.00, obtido %s", payroll.GrossPay)
+	}
+	if !payroll.INSSBase().Equal(salary) || !payroll.IRRFBase().Equal(salary) || !payroll.FGTSBase().Equal(salary) {
+		t.Errorf("As bases devem continuar em %s, obtidas INSS %s, IRRF %s e FGTS %s", salary, payroll.INSSBase(), payroll.IRRFBase(), payroll.FGTSBase())
+	}
+	// INSS de 373,41 e IRRF de 114,76 sobre R$ 4.000,00, mais as verbas de R$ 600,00
+	if !payroll.NetPay().Equal(decimal.NewFromFloat(4111.83)) {
+		t.Errorf("Líquido esperado 4111.83, obtido %s", payroll.NetPay())
+	}
+}
+
+// TestTaxConfig_AllowanceIncidence testa a incidência padrão dos prêmios e a
+// substituição configurada na tabela
+func TestTaxConfig_AllowanceIncidence(t *testing.T) {
+	config := newTaxConfig2025()
+
+	if incidence := config.AllowanceIncidence(AllowanceBonus); incidence != (Incidence{IRRF: true}) {
+		t.Errorf("Prêmios devem ter incidência só de IRRF por padrão, obtido %+v", incidence)
+	}
+
+	config.AllowanceIncidences = map[AllowanceType]Incidence{AllowanceBonus: FullIncidence}
+	if incidence := config.AllowanceIncidence(AllowanceBonus); incidence != FullIncidence {
+		t.Errorf("A incidência configurada deve prevalecer, obtido %+v", incidence)
+	}
+	if incidence := config.AllowanceIncidence(AllowancePerDiem); incidence != (Incidence{}) {
+		t.Errorf("Tipos não configurados mantêm a incidência padrão, obtido %+v", incidence)
+	}
+
+	config.AllowanceIncidences = map[AllowanceType]Incidence{"gorjeta": FullIncidence}
+	if err := config.Validate(); err == nil {
+		t.Error("Tipos de verba desconhecidos devem ser rejeitados")
+	}
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	monthsPerYear = 12
+	// minDaysForAvo é a fração mínima do mês que conta como um avo
+	minDaysForAvo = 15
+)
+
+// Avos é a quantidade de doze avos a que o empregado tem direito no 13º
+// salário e nas férias proporcionais
+type Avos int
+
+// Apply calcula a parte proporcional de um valor anual ou mensal integral
+func (a Avos) Apply(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(decimal.NewFromInt(int64(a))).Div(decimal.NewFromInt(monthsPerYear))
+}
+
+func (a Avos) String() string {
+	return fmt.Sprintf("%d/12 avos", int(a))
+}
+
+// ThirteenthSalaryAvos conta os meses do ano com ao menos 15 dias trabalhados
+// entre a admissão e o desligamento, ambos opcionais e inclusivos
+func ThirteenthSalaryAvos(year int, admission, termination *time.Time) Avos {
+	var avos Avos
+	for month := time.January; month <= time.December; month++ {
+		competence := NewCompetence(year, month)
+		start, end := competence.FirstDay(), competence.FirstDay().AddDate(0, 0, competence.Days()-1)
+		if admission != nil && admission.After(start) {
+			start = *admission
+		}
+		if termination != nil && termination.Before(end) {
+			end = *termination
+		}
+		if daysBetween(start, end) >= minDaysForAvo {
+			avos++
+		}
+	}
+	return avos
+}
+
+// daysBetween conta os dias de start a end, inclusive, ou zero se end é anterior
+func daysBetween(start, end time.Time) int {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	if end.Before(start) {
+		return 0
+	}
+	return int(end.Sub(start).Hours()/24) + 1
+}
+
+// AvosEarning é um provento proporcional aos avos, como o 13º salário e as
+// médias de variáveis que o compõem
+type AvosEarning struct {
+	name      string
+	amount    decimal.Decimal
+	avos      Avos
+	incidence Incidence
+}
+
+func NewAvosEarning(name string, amount decimal.Decimal, avos Avos, incidence Incidence) *AvosEarning {
+	return &AvosEarning{
+		name:      name,
+		amount:    amount,
+		avos:      avos,
+		incidence: incidence,
+	}
+}
+
+func (a AvosEarning) Value() decimal.Decimal {
+	return a.avos.Apply(a.amount).RoundBank(2)
+}
+
+func (a AvosEarning) Name() string {
+	return fmt.Sprintf("%s (%s)", a.name, a.avos)
+}
+
+func (a AvosEarning) Incidence() Incidence {
+	return a.incidence
+}
@@ -0,0 +1,25 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+type BaseSalary struct {
+	amount decimal.Decimal
+}
+
+func NewBaseSalary(amount decimal.Decimal) *BaseSalary {
+	return &BaseSalary{
+		amount: amount,
+	}
+}
+
+func (bs BaseSalary) Value() decimal.Decimal {
+	return bs.amount.RoundBank(2)
+}
+
+func (bs BaseSalary) Name() string {
+	return "Salário base"
+}
+
+func (bs BaseSalary) Incidence() Incidence {
+	return FullIncidence
+}
@@ -0,0 +1,41 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// Commission é o provento variável das comissões sobre vendas, pago junto
+// com a parte fixa do salário
+type Commission struct {
+	amount decimal.Decimal
+}
+
+func NewCommission(amount decimal.Decimal) *Commission {
+	return &Commission{
+		amount: amount,
+	}
+}
+
+func (c Commission) Value() decimal.Decimal {
+	return c.amount.RoundBank(2)
+}
+
+func (c Commission) Name() string {
+	return "Comissões"
+}
+
+func (c Commission) Incidence() Incidence {
+	return FullIncidence
+}
+
+// CommissionEarnings monta as comissões e o reflexo delas no DSR, calculado
+// pelos dias úteis e de descanso da competência, omitindo as linhas quando
+// não há comissões
+func CommissionEarnings(amount decimal.Decimal, month WorkMonth) []Earning {
+	if !amount.IsPositive() {
+		return nil
+	}
+	commission := NewCommission(amount)
+	return []Earning{
+		commission,
+		NewDSRReflection("DSR sobre comissões", month, commission),
+	}
+}
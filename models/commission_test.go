@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestPayroll_Commissions testa parte fixa mais comissões com o DSR em março
+// de 2026, que tem 26 dias úteis e 5 domingos
+func TestPayroll_Commissions(t *testing.T) {
+	earnings := append([]Earning{NewBaseSalary(decimal.NewFromFloat(2000.00))},
+		CommissionEarnings(decimal.NewFromFloat(1300.00), WorkMonthOf(NewCompetence(2026, time.March)))...)
+
+	payroll := NewPayrollFromEarnings(earnings, 0, newTaxConfig2026())
+
+	expected := []struct {
+		name  string
+		value float64
+	}{
+		{"Salário base", 2000.00},
+		{"Comissões", 1300.00},
+		// 1.300,00 / 26 × 5
+		{"DSR sobre comissões", 250.00},
+	}
+	for i, e := range expected {
+		earning := payroll.Earnings[i]
+		if earning.Name() != e.name || !earning.Value().Equal(decimal.NewFromFloat(e.value)) {
+			t.Errorf("Provento %d: esperado %s de %.2f, obtido %s de %s", i, e.name, e.value, earning.Name(), earning.Value())
+		}
+	}
+
+	// INSS e IRRF sobre a base combinada de R$ 3.550,00
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"Base do INSS", payroll.INSSBase(), 3550.00},
+		// 121,57 + 115,37 + 647,16 × 12%
+		{"INSS", payroll.Discounts[0].Value(), 314.59},
+		// Zerado pela redução da Lei nº 15.270/2025
+		{"IRRF", payroll.Discounts[1].Value(), 0.00},
+		{"Líquido", payroll.NetPay(), 3235.41},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+
+	if len(CommissionEarnings(decimal.Zero, WorkMonth{BusinessDays: 26, RestDays: 5})) != 0 {
+		t.Error("Sem comissões não deve haver proventos")
+	}
+}
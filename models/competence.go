@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const competenceLayout = "2006-01"
+
+// Competence representa o mês de referência (competência) de uma folha de pagamento
+type Competence struct {
+	Year  int
+	Month time.Month
+}
+
+func NewCompetence(year int, month time.Month) Competence {
+	return Competence{Year: year, Month: month}
+}
+
+// CompetenceOf retorna a competência correspondente a uma data
+func CompetenceOf(t time.Time) Competence {
+	return NewCompetence(t.Year(), t.Month())
+}
+
+// ParseCompetence interpreta uma competência no formato AAAA-MM (ex.: 2026-01)
+func ParseCompetence(value string) (Competence, error) {
+	t, err := time.Parse(competenceLayout, value)
+	if err != nil {
+		return Competence{}, fmt.Errorf("invalid competence %q: expected format YYYY-MM", value)
+	}
+	return CompetenceOf(t), nil
+}
+
+func (c Competence) IsZero() bool {
+	return c.Year == 0 && c.Month == 0
+}
+
+func (c Competence) Before(other Competence) bool {
+	if c.Year != other.Year {
+		return c.Year < other.Year
+	}
+	return c.Month < other.Month
+}
+
+func (c Competence) After(other Competence) bool {
+	return other.Before(c)
+}
+
+// FirstDay retorna o primeiro dia da competência, em UTC
+func (c Competence) FirstDay() time.Time {
+	return time.Date(c.Year, c.Month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Days retorna a quantidade de dias do mês da competência
+func (c Competence) Days() int {
+	return c.FirstDay().AddDate(0, 1, -1).Day()
+}
+
+func (c Competence) String() string {
+	return fmt.Sprintf("%04d-%02d", c.Year, int(c.Month))
+}
+
+func (c Competence) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c *Competence) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseCompetence(value)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
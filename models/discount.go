@@ -6,3 +6,11 @@ type Discount interface {
 	Value() decimal.Decimal
 	Name() string
 }
+
+// BaseReducingDiscount é um desconto que, ao contrário dos descontos comuns
+// aplicados depois dos impostos, reduz as bases dos tributos indicados na
+// incidência, como as faltas e os atrasos
+type BaseReducingDiscount interface {
+	Discount
+	Incidence() Incidence
+}
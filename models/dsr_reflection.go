@@ -0,0 +1,35 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// DSRReflection é o reflexo de proventos variáveis sobre o descanso semanal
+// remunerado. Tem a mesma incidência das verbas que o originam.
+type DSRReflection struct {
+	name     string
+	month    WorkMonth
+	earnings []Earning
+}
+
+func NewDSRReflection(name string, month WorkMonth, earnings ...Earning) *DSRReflection {
+	return &DSRReflection{
+		name:     name,
+		month:    month,
+		earnings: earnings,
+	}
+}
+
+func (d DSRReflection) Value() decimal.Decimal {
+	total := decimal.Zero
+	for _, earning := range d.earnings {
+		total = total.Add(earning.Value())
+	}
+	return d.month.DSR(total).RoundBank(2)
+}
+
+func (d DSRReflection) Name() string {
+	return d.name
+}
+
+func (d DSRReflection) Incidence() Incidence {
+	return FullIncidence
+}
@@ -0,0 +1,19 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// Incidence indica se um provento compõe a base do INSS, do IRRF e do FGTS
+type Incidence struct {
+	INSS bool `json:"inss"`
+	IRRF bool `json:"irrf"`
+	FGTS bool `json:"fgts"`
+}
+
+// FullIncidence é a incidência das verbas salariais comuns
+var FullIncidence = Incidence{INSS: true, IRRF: true, FGTS: true}
+
+type Earning interface {
+	Value() decimal.Decimal
+	Name() string
+	Incidence() Incidence
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+//go:embed data/official_tax_rules.yaml
+var officialTaxRules []byte
+
+// EmbeddedTaxTableProvider lê as tabelas oficiais de INSS e IRRF embutidas no
+// binário, desde janeiro de 2020
+type EmbeddedTaxTableProvider struct{}
+
+func NewEmbeddedTaxTableProvider() *EmbeddedTaxTableProvider {
+	return &EmbeddedTaxTableProvider{}
+}
+
+func (p *EmbeddedTaxTableProvider) Load() (*TaxRules, error) {
+	data, err := yamlToJSON(officialTaxRules)
+	if err != nil {
+		return nil, fmt.Errorf("parsing embedded tax rules: %w", err)
+	}
+
+	var rules TaxRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("parsing embedded tax rules: %w", err)
+	}
+	return &rules, nil
+}
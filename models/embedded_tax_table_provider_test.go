@@ -0,0 +1,158 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestEmbeddedTaxTableProvider_CoversEveryMonth testa que as tabelas embutidas
+// são válidas e que cada competência desde 2020 tem exatamente uma tabela
+func TestEmbeddedTaxTableProvider_CoversEveryMonth(t *testing.T) {
+	rules, err := LoadTaxRules(NewEmbeddedTaxTableProvider())
+	if err != nil {
+		t.Fatalf("As tabelas embutidas devem ser válidas. Erro: %v", err)
+	}
+
+	for year := 2020; year <= 2026; year++ {
+		for month := time.January; month <= time.December; month++ {
+			competence := NewCompetence(year, month)
+			matches := 0
+			for i := range rules.Tables {
+				if rules.Tables[i].AppliesTo(competence) {
+					matches++
+				}
+			}
+			if matches != 1 {
+				t.Errorf("Competência %s deve ter exatamente uma tabela, encontradas %d", competence, matches)
+			}
+		}
+	}
+}
+
+// TestEmbeddedTaxTableProvider_HistoricalPayrolls testa cálculos de
+// competências passadas com as tabelas embutidas
+func TestEmbeddedTaxTableProvider_HistoricalPayrolls(t *testing.T) {
+	rules, err := NewEmbeddedTaxTableProvider().Load()
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	testCases := []struct {
+		name         string
+		competence   Competence
+		grossPay     float64
+		expectedINSS float64
+		expectedIRRF float64
+	}{
+		// Alíquota de 9% sobre todo o salário, antes da EC nº 103/2019
+		{"Fevereiro de 2020", NewCompetence(2020, time.February), 2000.00, 180.00, 0.00},
+		{"Junho de 2021", NewCompetence(2021, time.June), 3000.00, 277.39, 61.40},
+		{"Dezembro de 2025", NewCompetence(2025, time.December), 4000.00, 373.41, 114.76},
+		{"Janeiro de 2026", NewCompetence(2026, time.January), 4000.00, 368.59, 0.00},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := rules.ConfigFor(tc.competence)
+			if err != nil {
+				t.Fatalf("Erro inesperado: %v", err)
+			}
+
+			payroll := NewPayroll(decimal.NewFromFloat(tc.grossPay), 0, config)
+			if inss := payroll.Discounts[0].Value(); !inss.Equal(decimal.NewFromFloat(tc.expectedINSS)) {
+				t.Errorf("%s: INSS esperado %.2f, obtido %s", tc.name, tc.expectedINSS, inss)
+			}
+			if irrf := payroll.Discounts[1].Value(); !irrf.Equal(decimal.NewFromFloat(tc.expectedIRRF)) {
+				t.Errorf("%s: IRRF esperado %.2f, obtido %s", tc.name, tc.expectedIRRF, irrf)
+			}
+		})
+	}
+
+	if _, err := rules.ConfigFor(NewCompetence(2019, time.December)); err == nil {
+		t.Error("Não há tabelas embutidas antes de 2020")
+	}
+}
+
+// TestEmbeddedTaxTableProvider_MinimumWage testa o salário mínimo de
+// referência nas mudanças de valor no meio do ano
+func TestEmbeddedTaxTableProvider_MinimumWage(t *testing.T) {
+	rules, err := NewEmbeddedTaxTableProvider().Load()
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	testCases := []struct {
+		competence Competence
+		expected   float64
+	}{
+		{NewCompetence(2020, time.January), 1039.00},
+		{NewCompetence(2020, time.February), 1045.00},
+		{NewCompetence(2023, time.April), 1302.00},
+		{NewCompetence(2023, time.May), 1320.00},
+		{NewCompetence(2026, time.June), 1621.00},
+	}
+
+	for _, tc := range testCases {
+		config, err := rules.ConfigFor(tc.competence)
+		if err != nil {
+			t.Fatalf("Erro inesperado: %v", err)
+		}
+		if !config.MinimumWage.Equal(decimal.NewFromFloat(tc.expected)) {
+			t.Errorf("Competência %s: salário mínimo esperado %.2f, obtido %s", tc.competence, tc.expected, config.MinimumWage)
+		}
+	}
+}
+
+// TestEmbeddedTaxTableProvider_FamilyAllowance testa que toda competência tem
+// os parâmetros do salário-família
+func TestEmbeddedTaxTableProvider_FamilyAllowance(t *testing.T) {
+	rules, err := NewEmbeddedTaxTableProvider().Load()
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	for i := range rules.Tables {
+		if rules.Tables[i].FamilyAllowance == nil {
+			t.Errorf("Tabela de %s sem salário-família", rules.Tables[i].ValidFrom)
+		}
+	}
+
+	config, err := rules.ConfigFor(NewCompetence(2024, time.June))
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if !config.FamilyAllowance.Quota.Equal(decimal.NewFromFloat(62.04)) || !config.FamilyAllowance.IncomeCeiling.Equal(decimal.NewFromFloat(1819.26)) {
+		t.Errorf("Salário-família de 2024 esperado 62.04 até 1819.26, obtido %+v", config.FamilyAllowance)
+	}
+}
+
+// TestEmbeddedTaxTableProvider_PLRRanges testa o limite de isenção da tabela
+// de PLR nas atualizações de 2024 e 2025
+func TestEmbeddedTaxTableProvider_PLRRanges(t *testing.T) {
+	rules, err := NewEmbeddedTaxTableProvider().Load()
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	testCases := []struct {
+		competence Competence
+		exemption  float64
+	}{
+		{NewCompetence(2024, time.January), 6677.55},
+		{NewCompetence(2024, time.February), 7407.11},
+		{NewCompetence(2025, time.May), 7640.80},
+		{NewCompetence(2026, time.June), 7640.80},
+	}
+
+	for _, tc := range testCases {
+		config, err := rules.ConfigFor(tc.competence)
+		if err != nil {
+			t.Fatalf("Erro inesperado: %v", err)
+		}
+		if len(config.PLRRanges) == 0 || !config.PLRRanges[0].EndingValue.Equal(decimal.NewFromFloat(tc.exemption)) {
+			t.Errorf("Competência %s: isenção da PLR esperada até %.2f", tc.competence, tc.exemption)
+		}
+	}
+}
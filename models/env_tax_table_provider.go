@@ -0,0 +1,123 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/shopspring/decimal"
+
+	_ "github.com/joho/godotenv/autoload"
+)
+
+const envTaxRulesVersion = "env"
+
+// EnvTaxTableProvider lê as tabelas da variável TAX_TABLES ou, na ausência
+// dela, das variáveis avulsas INSS_RANGES, IRRF_RANGES, PLR_RANGES e
+// parâmetros do IRRF.
+// As variáveis são lidas a cada chamada de Load.
+type EnvTaxTableProvider struct{}
+
+func NewEnvTaxTableProvider() *EnvTaxTableProvider {
+	return &EnvTaxTableProvider{}
+}
+
+func (p *EnvTaxTableProvider) Load() (*TaxRules, error) {
+	version := getEnvOrDefault("TAX_RULES_VERSION", envTaxRulesVersion)
+
+	data := os.Getenv("TAX_TABLES")
+	if data == "" {
+		config, err := legacyTaxConfigFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		return &TaxRules{Version: version, Tables: []TaxConfig{config}}, nil
+	}
+
+	var tables []TaxConfig
+	if err := json.Unmarshal([]byte(data), &tables); err != nil {
+		return nil, fmt.Errorf("parsing TAX_TABLES: %w", err)
+	}
+	return &TaxRules{Version: version, Tables: tables}, nil
+}
+
+// legacyTaxConfigFromEnv monta uma tabela sem vigência definida a partir das
+// variáveis avulsas usadas antes de TAX_TABLES
+func legacyTaxConfigFromEnv() (TaxConfig, error) {
+	inssRanges, err := loadINSSRangesFromEnv()
+	if err != nil {
+		return TaxConfig{}, err
+	}
+	irrfRanges, err := loadIRRFRangesFromEnv()
+	if err != nil {
+		return TaxConfig{}, err
+	}
+
+	env := &envDecimalReader{}
+	config := TaxConfig{
+		INSSRanges:                    inssRanges,
+		IRRFRanges:                    irrfRanges,
+		DependentDeduction:            env.read("DEPENDENT_DEDUCTION_AMOUNT", ""),
+		SimplifiedDeductionPercentage: env.read("IRRF_SIMPLIFIED_DEDUCTION_PERCENTAGE", "0.25"),
+		MinimumWage:                   env.read("MINIMUM_WAGE", "0"),
+		// Nova regra de redução do IRRF 2026 (Lei nº 15.270/2025)
+		// Ampliação da faixa de isenção para rendimentos até R$ 5.000,00
+		IRRFReduction: &IRRFReduction{
+			MaxAmount:  env.read("IRRF_MAX_REDUCTION_AMOUNT", "312.89"),
+			Threshold:  env.read("IRRF_REDUCTION_THRESHOLD", "5000.00"),
+			UpperLimit: env.read("IRRF_REDUCTION_UPPER_LIMIT", "7350.00"),
+			Constant:   env.read("IRRF_REDUCTION_CONSTANT", "978.62"),
+			Multiplier: env.read("IRRF_REDUCTION_MULTIPLIER", "0.133145"),
+		},
+	}
+	if os.Getenv("PLR_RANGES") != "" {
+		if err := json.Unmarshal([]byte(os.Getenv("PLR_RANGES")), &config.PLRRanges); err != nil {
+			return TaxConfig{}, fmt.Errorf("parsing PLR_RANGES: %w", err)
+		}
+	}
+	if os.Getenv("FAMILY_ALLOWANCE_QUOTA") != "" {
+		config.FamilyAllowance = &FamilyAllowanceConfig{
+			Quota:         env.read("FAMILY_ALLOWANCE_QUOTA", ""),
+			IncomeCeiling: env.read("FAMILY_ALLOWANCE_INCOME_CEILING", ""),
+		}
+	}
+	return config, env.err
+}
+
+// envDecimalReader lê variáveis decimais guardando o primeiro erro encontrado
+type envDecimalReader struct {
+	err error
+}
+
+func (r *envDecimalReader) read(key, defaultValue string) decimal.Decimal {
+	value, err := decimal.NewFromString(getEnvOrDefault(key, defaultValue))
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("parsing %s: %w", key, err)
+	}
+	return value
+}
+
+func loadINSSRangesFromEnv() ([]INSSRange, error) {
+	var ranges []INSSRange
+	if err := json.Unmarshal([]byte(os.Getenv("INSS_RANGES")), &ranges); err != nil {
+		return nil, fmt.Errorf("parsing INSS_RANGES: %w", err)
+	}
+	return ranges, nil
+}
+
+func loadIRRFRangesFromEnv() ([]IRRFRange, error) {
+	var ranges []IRRFRange
+	if err := json.Unmarshal([]byte(os.Getenv("IRRF_RANGES")), &ranges); err != nil {
+		return nil, fmt.Errorf("parsing IRRF_RANGES: %w", err)
+	}
+	return ranges, nil
+}
+
+// getEnvOrDefault retorna o valor da variável de ambiente ou um valor padrão se não estiver definida
+func getEnvOrDefault(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
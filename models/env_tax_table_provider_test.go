@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestEnvTaxTableProvider_LegacyVariables testa a montagem da tabela a partir
+// das variáveis avulsas usadas antes de TAX_TABLES
+func TestEnvTaxTableProvider_LegacyVariables(t *testing.T) {
+	t.Setenv("TAX_TABLES", "")
+	t.Setenv("DEPENDENT_DEDUCTION_AMOUNT", "189.59")
+	t.Setenv("INSS_RANGES", `[{"index": 1, "aliquot": "0.075", "init_value": "0.00", "end_value": "1621.00"}]`)
+	t.Setenv("IRRF_RANGES", `[{"init_value": "0.00", "end_value": "2428.80", "aliquot": "0.00", "deduction": "0.00"}]`)
+
+	rules, err := LoadTaxRules(NewEnvTaxTableProvider())
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	config, err := rules.ConfigFor(NewCompetence(2026, 1))
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if !config.DependentDeduction.Equal(decimal.NewFromFloat(189.59)) {
+		t.Errorf("Dedução por dependente esperada 189.59, obtida %s", config.DependentDeduction)
+	}
+	if config.IRRFReduction == nil || !config.IRRFReduction.MaxAmount.Equal(decimal.NewFromFloat(312.89)) {
+		t.Errorf("A redução deve usar os valores padrão da Lei nº 15.270/2025. Obtido: %+v", config.IRRFReduction)
+	}
+
+	t.Setenv("IRRF_RANGES", "not json")
+	if _, err := LoadTaxRules(NewEnvTaxTableProvider()); err == nil {
+		t.Error("IRRF_RANGES inválido deve retornar erro")
+	}
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// FamilyAllowance é o salário-família: uma cota por filho menor de 14 anos ou
+// inválido, devida quando a remuneração não passa do limite da tabela
+// vigente. É pago pela empresa e compensado na guia do INSS, por isso não
+// tem incidência e não compõe o salário bruto.
+type FamilyAllowance struct {
+	config       *FamilyAllowanceConfig
+	remuneration decimal.Decimal
+	children     int64
+}
+
+func NewFamilyAllowance(config *FamilyAllowanceConfig, remuneration decimal.Decimal, children int64) *FamilyAllowance {
+	return &FamilyAllowance{
+		config:       config,
+		remuneration: remuneration,
+		children:     children,
+	}
+}
+
+// IsEligible indica se a remuneração dá direito ao benefício
+func (f FamilyAllowance) IsEligible() bool {
+	return f.config != nil && f.children > 0 && f.remuneration.LessThanOrEqual(f.config.IncomeCeiling)
+}
+
+func (f FamilyAllowance) Value() decimal.Decimal {
+	if !f.IsEligible() {
+		return decimal.Zero
+	}
+	return f.config.Quota.Mul(decimal.NewFromInt(f.children)).RoundBank(2)
+}
+
+func (f FamilyAllowance) Name() string {
+	return fmt.Sprintf("Salário-família (%s)", countLabel(decimal.NewFromInt(f.children), "cota", "cotas"))
+}
+
+func (f FamilyAllowance) Incidence() Incidence {
+	return Incidence{}
+}
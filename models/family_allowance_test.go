@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestPayroll_FamilyAllowance testa o salário-família de 2026: cota de R$ 67,54
+// para remuneração até R$ 1.980,38
+func TestPayroll_FamilyAllowance(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grossPay float64
+		children int64
+		expected float64
+	}{
+		{"Dois filhos dentro do limite", 1800.00, 2, 135.08},
+		{"Exatamente no limite", 1980.38, 1, 67.54},
+		{"Acima do limite", 1980.39, 1, 0.00},
+		{"Sem filhos", 1800.00, 0, 0.00},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payroll := NewPayroll(decimal.NewFromFloat(tc.grossPay), 0, newTaxConfig2026())
+			payroll.AddFamilyAllowance(tc.children)
+
+			if !payroll.TotalBenefits().Equal(decimal.NewFromFloat(tc.expected)) {
+				t.Errorf("%s: salário-família esperado %.2f, obtido %s", tc.name, tc.expected, payroll.TotalBenefits())
+			}
+			// O benefício não compõe o bruto nem as bases, mas soma ao líquido
+			if !payroll.GrossPay.Equal(decimal.NewFromFloat(tc.grossPay)) {
+				t.Errorf("%s: o bruto não deve incluir o salário-família, obtido %s", tc.name, payroll.GrossPay)
+			}
+			expectedNet := payroll.GrossPay.Sub(payroll.TotalDiscount()).Add(decimal.NewFromFloat(tc.expected))
+			if !payroll.NetPay().Equal(expectedNet) {
+				t.Errorf("%s: líquido esperado %s, obtido %s", tc.name, expectedNet, payroll.NetPay())
+			}
+		})
+	}
+}
+
+// TestPayroll_FamilyAllowanceNotConfigured testa tabelas sem salário-família
+func TestPayroll_FamilyAllowanceNotConfigured(t *testing.T) {
+	config := newTaxConfig2026()
+	config.FamilyAllowance = nil
+
+	payroll := NewPayroll(decimal.NewFromFloat(1800.00), 0, config)
+	payroll.AddFamilyAllowance(2)
+
+	if len(payroll.Benefits) != 0 {
+		t.Errorf("Sem parâmetros na tabela não deve haver salário-família, obtidos %d benefícios", len(payroll.Benefits))
+	}
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// activeVersionFile é o arquivo do diretório com a versão ativada pela API.
+// Sem a extensão .json, ele não é listado como uma versão publicada.
+const activeVersionFile = "active"
+
+// FileTaxRulesStore guarda cada versão em um arquivo JSON do diretório, de
+// modo que as versões publicadas sobrevivem a reinícios e podem ser
+// compartilhadas entre réplicas que montam o mesmo diretório. Os arquivos
+// das versões nunca são sobrescritos; só o da versão ativada é substituído a
+// cada ativação.
+type FileTaxRulesStore struct {
+	Dir string
+}
+
+func NewFileTaxRulesStore(dir string) (*FileTaxRulesStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating tax rules store directory: %w", err)
+	}
+	return &FileTaxRulesStore{Dir: dir}, nil
+}
+
+// List retorna as versões na ordem de publicação
+func (s *FileTaxRulesStore) List() ([]*TaxRules, error) {
+	paths, err := filepath.Glob(filepath.Join(s.Dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	type published struct {
+		rules *TaxRules
+		info  fs.FileInfo
+	}
+	entries := make([]published, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		rules, err := readTaxRulesFile(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, published{rules: rules, info: info})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].info.ModTime().Before(entries[j].info.ModTime())
+	})
+
+	versions := make([]*TaxRules, len(entries))
+	for i, entry := range entries {
+		versions[i] = entry.rules
+	}
+	return versions, nil
+}
+
+func (s *FileTaxRulesStore) Get(version string) (*TaxRules, error) {
+	path, err := s.path(version)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := readTaxRulesFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %q", ErrTaxRulesVersionNotFound, version)
+	}
+	return rules, err
+}
+
+// Save grava a versão em um arquivo temporário e o liga ao nome definitivo,
+// o que falha se outra réplica já tiver publicado a mesma versão
+func (s *FileTaxRulesStore) Save(rules *TaxRules) error {
+	path, err := s.path(rules.Version)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.Dir, ".publishing-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Link(tmp.Name(), path); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return fmt.Errorf("%w: %q", ErrTaxRulesVersionExists, rules.Version)
+		}
+		return err
+	}
+	return nil
+}
+
+// SetActive grava a versão em um arquivo temporário e o renomeia sobre o
+// arquivo da versão ativada, para que uma leitura nunca veja um arquivo pela
+// metade
+func (s *FileTaxRulesStore) SetActive(version string) error {
+	if _, err := s.path(version); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.Dir, ".activating-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(version); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.Dir, activeVersionFile))
+}
+
+func (s *FileTaxRulesStore) Active() (string, error) {
+	data, err := os.ReadFile(filepath.Join(s.Dir, activeVersionFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (s *FileTaxRulesStore) path(version string) (string, error) {
+	if version == "" || version == "." || version == ".." || strings.ContainsAny(version, `/\`) {
+		return "", fmt.Errorf("invalid tax rules version %q", version)
+	}
+	return filepath.Join(s.Dir, version+".json"), nil
+}
+
+func readTaxRulesFile(path string) (*TaxRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rules TaxRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("parsing stored tax rules %s: %w", path, err)
+	}
+	return &rules, nil
+}
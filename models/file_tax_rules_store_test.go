@@ -0,0 +1,58 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestFileTaxRulesStore testa que as versões publicadas sobrevivem a um novo
+// store no mesmo diretório, como após um reinício
+func TestFileTaxRulesStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileTaxRulesStore(dir)
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	for _, version := range []string{"2026.1", "2026.2"} {
+		if err := store.Save(&TaxRules{Version: version, Tables: []TaxConfig{*newTaxConfig2026()}}); err != nil {
+			t.Fatalf("Erro inesperado: %v", err)
+		}
+	}
+	if err := store.Save(&TaxRules{Version: "2026.1"}); !errors.Is(err, ErrTaxRulesVersionExists) {
+		t.Errorf("Publicar uma versão existente deve retornar ErrTaxRulesVersionExists. Obtido: %v", err)
+	}
+	if err := store.Save(&TaxRules{Version: "../2026.3"}); err == nil {
+		t.Error("Versões com separador de diretório devem ser recusadas")
+	}
+	if err := store.SetActive("2026.2"); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	restarted, err := NewFileTaxRulesStore(dir)
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	rules, err := restarted.Get("2026.1")
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if !rules.SameTables(&TaxRules{Tables: []TaxConfig{*newTaxConfig2026()}}) {
+		t.Error("As tabelas lidas devem ser iguais às publicadas")
+	}
+	if _, err := restarted.Get("2027.1"); !errors.Is(err, ErrTaxRulesVersionNotFound) {
+		t.Errorf("Versão inexistente deve retornar ErrTaxRulesVersionNotFound. Obtido: %v", err)
+	}
+
+	if version, err := restarted.Active(); err != nil || version != "2026.2" {
+		t.Errorf("Versão ativada esperada 2026.2 após o reinício, obtida %q (%v)", version, err)
+	}
+
+	versions, err := restarted.List()
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != "2026.1" || versions[1].Version != "2026.2" {
+		t.Errorf("Esperadas as versões 2026.1 e 2026.2 na ordem de publicação, obtidas %d", len(versions))
+	}
+}
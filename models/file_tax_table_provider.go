@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileTaxTableProvider lê as regras de um arquivo versionado em YAML (.yaml,
+// .yml) ou JSON. Os dois formatos usam os mesmos nomes de campos.
+type FileTaxTableProvider struct {
+	Path string
+}
+
+func NewFileTaxTableProvider(path string) *FileTaxTableProvider {
+	return &FileTaxTableProvider{Path: path}
+}
+
+func (p *FileTaxTableProvider) Load() (*TaxRules, error) {
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, fmt.Errorf("reading tax rules file: %w", err)
+	}
+
+	if ext := strings.ToLower(filepath.Ext(p.Path)); ext == ".yaml" || ext == ".yml" {
+		if data, err = yamlToJSON(data); err != nil {
+			return nil, fmt.Errorf("parsing tax rules file %s: %w", p.Path, err)
+		}
+	}
+
+	var rules TaxRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("parsing tax rules file %s: %w", p.Path, err)
+	}
+	if rules.Version == "" {
+		return nil, fmt.Errorf("tax rules file %s has no version", p.Path)
+	}
+	return &rules, nil
+}
+
+// yamlToJSON converte o documento para JSON para reaproveitar as tags json
+// e a decodificação de decimal.Decimal e Competence
+func yamlToJSON(data []byte) ([]byte, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(document)
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestFileTaxTableProvider_LoadYAML testa a leitura do arquivo de regras de exemplo
+func TestFileTaxTableProvider_LoadYAML(t *testing.T) {
+	rules, err := NewFileTaxTableProvider("../resources/tax_rules.example.yaml").Load()
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	if err := rules.Validate(); err != nil {
+		t.Errorf("O arquivo de exemplo deve ser válido. Erro: %v", err)
+	}
+
+	if rules.Version != "2026.1" {
+		t.Errorf("Versão esperada 2026.1, obtida %q", rules.Version)
+	}
+
+	config, err := rules.ConfigFor(NewCompetence(2026, time.March))
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if len(config.INSSRanges) != 4 || len(config.IRRFRanges) != 5 {
+		t.Errorf("Esperadas 4 faixas de INSS e 5 de IRRF, obtidas %d e %d", len(config.INSSRanges), len(config.IRRFRanges))
+	}
+	if len(config.IRRFAdjustments) != 1 {
+		t.Fatalf("A tabela de 2026 deve ter o ajuste da Lei nº 15.270/2025. Obtido: %+v", config.IRRFAdjustments)
+	}
+	reduction, ok := config.IRRFAdjustments[0].Adjustment.(*IRRFReduction)
+	if !ok || !reduction.Multiplier.Equal(decimal.RequireFromString("0.133145")) {
+		t.Errorf("Parâmetros da redução de 2026 não carregados: %+v", config.IRRFAdjustments[0])
+	}
+
+	config, err = rules.ConfigFor(NewCompetence(2025, time.December))
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if len(config.IRRFAdjustmentPipeline()) != 0 {
+		t.Error("A tabela de 2025 não deve ter redução do IRRF")
+	}
+}
+
+// TestFileTaxTableProvider_MissingFile testa o erro para arquivo inexistente
+func TestFileTaxTableProvider_MissingFile(t *testing.T) {
+	if _, err := NewFileTaxTableProvider("does-not-exist.yaml").Load(); err == nil {
+		t.Error("Arquivo inexistente deve retornar erro")
+	}
+}
@@ -0,0 +1,58 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// HourlyPay é o salário do empregado horista: o valor da hora multiplicado
+// pelas horas trabalhadas no mês
+type HourlyPay struct {
+	hourlyRate  decimal.Decimal
+	hoursWorked decimal.Decimal
+}
+
+func NewHourlyPay(hourlyRate, hoursWorked decimal.Decimal) *HourlyPay {
+	return &HourlyPay{
+		hourlyRate:  hourlyRate,
+		hoursWorked: hoursWorked,
+	}
+}
+
+func (h HourlyPay) Value() decimal.Decimal {
+	return h.hourlyRate.Mul(h.hoursWorked).RoundBank(2)
+}
+
+func (h HourlyPay) Name() string {
+	return "Salário horista"
+}
+
+func (h HourlyPay) Incidence() Incidence {
+	return FullIncidence
+}
+
+// HourlyRate é o valor da hora normal de um salário mensal: o salário
+// dividido pela jornada mensal contratual
+func HourlyRate(monthlySalary, monthlyHours decimal.Decimal) decimal.Decimal {
+	if !monthlyHours.IsPositive() {
+		return decimal.Zero
+	}
+	return monthlySalary.Div(monthlyHours)
+}
+
+// HourlyPayEarnings monta o salário do horista e o descanso semanal
+// remunerado, que para o horista não está incluído no valor da hora
+func HourlyPayEarnings(hourlyRate, hoursWorked decimal.Decimal, month WorkMonth) []Earning {
+	pay := NewHourlyPay(hourlyRate, hoursWorked)
+	return []Earning{
+		pay,
+		NewDSRReflection("Descanso semanal remunerado", month, pay),
+	}
+}
+
+// RestDayRate é o valor de um dia de descanso do horista: o DSR do mês
+// dividido pelos dias de descanso. É a referência da perda do DSR nas semanas
+// com falta, já que as horas não trabalhadas simplesmente não são pagas.
+func RestDayRate(dsr decimal.Decimal, month WorkMonth) decimal.Decimal {
+	if month.RestDays <= 0 {
+		return decimal.Zero
+	}
+	return dsr.Div(decimal.NewFromInt(int64(month.RestDays)))
+}
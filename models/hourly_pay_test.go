@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestHourlyPayroll testa o salário do horista com o DSR
+// R$ 15,00 por hora, 176 horas em um mês com 22 dias úteis e 8 de descanso
+func TestHourlyPayroll(t *testing.T) {
+	month := WorkMonth{BusinessDays: 22, RestDays: 8}
+	payroll := NewPayrollFromEarnings(HourlyPayEarnings(decimal.NewFromFloat(15.00), decimal.NewFromInt(176), month), 0, newTaxConfig2025())
+
+	expected := []struct {
+		name  string
+		value float64
+	}{
+		{"Salário horista", 2640.00},
+		// 2.640,00 / 22 × 8
+		{"Descanso semanal remunerado", 960.00},
+	}
+	if len(payroll.Earnings) != len(expected) {
+		t.Fatalf("Esperados %d proventos, obtidos %d", len(expected), len(payroll.Earnings))
+	}
+	for i, e := range expected {
+		earning := payroll.Earnings[i]
+		if earning.Name() != e.name || !earning.Value().Equal(decimal.NewFromFloat(e.value)) {
+			t.Errorf("Provento %d: esperado %s de %.2f, obtido %s de %s", i, e.name, e.value, earning.Name(), earning.Value())
+		}
+	}
+
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"Base do INSS", payroll.INSSBase(), 3600.00},
+		// 113,85 + 114,83 + 806,12 × 12%
+		{"INSS", payroll.Discounts[0].Value(), 325.41},
+		// (3.600,00 - 607,20) × 15% - 394,16
+		{"IRRF", payroll.Discounts[1].Value(), 54.76},
+		{"Líquido", payroll.NetPay(), 3219.83},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
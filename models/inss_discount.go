@@ -1,17 +1,12 @@
 package models
 
 import (
-	"encoding/json"
-	"log"
-	"os"
-
 	"github.com/shopspring/decimal"
-
-	_ "github.com/joho/godotenv/autoload"
 )
 
 type INSSDiscount struct {
 	GrossPay decimal.Decimal
+	Config   *TaxConfig
 }
 
 type INSSRange struct {
@@ -21,8 +16,11 @@ type INSSRange struct {
 	EndValue  decimal.Decimal `json:"end_value"`
 }
 
-func NewINSSDiscount(grosspay decimal.Decimal) *INSSDiscount {
-	return &INSSDiscount{GrossPay: grosspay}
+func NewINSSDiscount(grosspay decimal.Decimal, config *TaxConfig) *INSSDiscount {
+	return &INSSDiscount{
+		GrossPay: grosspay,
+		Config:   config,
+	}
 }
 
 func NewINSSRange(index int, aliquot decimal.Decimal, initValue decimal.Decimal, endValue decimal.Decimal) *INSSRange {
@@ -34,64 +32,45 @@ func NewINSSRange(index int, aliquot decimal.Decimal, initValue decimal.Decimal,
 	}
 }
 
-var (
-	INSS_RANGE_5_DISCOUNT_AMOUNT, _ = decimal.NewFromString(os.Getenv("INSS_RANGE_5_DISCOUNT_AMOUNT"))
-	INSSRanges                      = loadINSSRangesFromEnv()
-)
-
-func loadINSSRangesFromEnv() []INSSRange {
-	data := os.Getenv("INSS_RANGES")
-	var ranges []INSSRange
-	if err := json.Unmarshal([]byte(data), &ranges); err != nil {
-		log.Printf("Error loading INSS ranges: %v", err)
+// taxedAmount retorna a parte da base de contribuição que cai dentro da faixa.
+// A faixa começa R$ 0,01 acima do fim da anterior, exceto a primeira.
+func (ir INSSRange) taxedAmount(contributionBase decimal.Decimal) decimal.Decimal {
+	if contributionBase.LessThan(ir.InitValue) {
+		return decimal.Zero
 	}
-	log.Printf("Loaded INSS ranges: %+v", ranges)
-	return ranges
-}
 
-func findINSSRangeByGrossPay(grossPay decimal.Decimal) INSSRange {
-	for _, inssRange := range INSSRanges {
-		if grossPay.GreaterThanOrEqual(inssRange.InitValue) && grossPay.LessThanOrEqual(inssRange.EndValue) {
-			return inssRange
-		}
+	lowerBound := ir.InitValue
+	if ir.Index > 1 {
+		lowerBound = lowerBound.Sub(rangeStep)
 	}
-	return INSSRange{}
-}
-
-func (ir INSSRange) calculateRangeDiscount() decimal.Decimal {
-	if ir.Index == 1 {
-		return ir.Aliquot.Mul(ir.EndValue)
-	}
-
-	rangeDifference := ir.EndValue.Sub(ir.InitValue.Sub(decimal.NewFromFloat(0.01)))
-	return rangeDifference.Mul(ir.Aliquot)
-}
-
-func (ir INSSRange) calculatePreviousRangesDiscount() decimal.Decimal {
-	totalDiscount := decimal.Zero
-	for _, inssRange := range INSSRanges {
-		if inssRange.Index < ir.Index {
-			totalDiscount = totalDiscount.Add(inssRange.calculateRangeDiscount())
-		}
-	}
-	return totalDiscount
+	return decimal.Min(contributionBase, ir.EndValue).Sub(lowerBound)
 }
 
+// Value calcula a contribuição progressiva somando a alíquota de cada faixa
+// sobre a parte do salário que cai nela. Salários acima do teto contribuem
+// sobre o teto.
 func (i INSSDiscount) Value() decimal.Decimal {
-	inssRange := findINSSRangeByGrossPay(i.GrossPay)
+	contributionBase := decimal.Min(i.GrossPay, i.Config.INSSCeiling())
 
-	if inssRange.Index == 1 {
-		return inssRange.calculateRangeDiscount()
+	if i.Config.INSSFlatRate {
+		return i.flatRateValue(contributionBase)
 	}
 
-	if inssRange.Index == len(INSSRanges) {
-		return INSS_RANGE_5_DISCOUNT_AMOUNT
+	total := decimal.Zero
+	for _, inssRange := range i.Config.INSSRanges {
+		total = total.Add(inssRange.taxedAmount(contributionBase).Mul(inssRange.Aliquot))
 	}
+	return total.Truncate(2)
+}
 
-	currentRangeAmount := i.GrossPay.Sub(inssRange.InitValue.Sub(decimal.NewFromFloat(0.01)))
-	currentRangeDiscount := currentRangeAmount.Mul(inssRange.Aliquot)
-
-	return currentRangeDiscount.Add(inssRange.calculatePreviousRangesDiscount()).Truncate(2)
+// flatRateValue aplica a alíquota da faixa do salário sobre todo o salário
+func (i INSSDiscount) flatRateValue(contributionBase decimal.Decimal) decimal.Decimal {
+	for _, inssRange := range i.Config.INSSRanges {
+		if contributionBase.LessThanOrEqual(inssRange.EndValue) {
+			return contributionBase.Mul(inssRange.Aliquot).Truncate(2)
+		}
+	}
+	return decimal.Zero
 }
 
 func (i INSSDiscount) Name() string {
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestINSSDiscount_Progressive testa o cálculo faixa a faixa com a tabela de 2026
+func TestINSSDiscount_Progressive(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grossPay float64
+		expected float64
+	}{
+		{"Salário mínimo", 1621.00, 121.57},
+		{"Segunda faixa", 2500.00, 200.68},
+		{"Terceira faixa", 4000.00, 368.59},
+		{"Quarta faixa", 5000.00, 501.51},
+		{"Exatamente no teto", 8475.55, 988.09},
+		{"Acima do teto", 20000.00, 988.09},
+	}
+
+	config := newTaxConfig2026()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := NewINSSDiscount(decimal.NewFromFloat(tc.grossPay), config).Value()
+			if !result.Equal(decimal.NewFromFloat(tc.expected)) {
+				t.Errorf("Para salário de R$ %.2f, o INSS esperado é R$ %.2f. Obtido: %s", tc.grossPay, tc.expected, result)
+			}
+		})
+	}
+}
+
+// TestINSSDiscount_ZeroAliquotRangeAboveCeiling testa tabelas antigas com uma
+// faixa de alíquota zero acima do teto
+func TestINSSDiscount_ZeroAliquotRangeAboveCeiling(t *testing.T) {
+	config := newTaxConfig2026()
+	config.INSSRanges = append(config.INSSRanges,
+		*NewINSSRange(5, decimal.Zero, decimal.RequireFromString("8475.56"), decimal.RequireFromString("999999999.99")))
+
+	if ceiling := config.INSSCeiling(); !ceiling.Equal(decimal.RequireFromString("8475.55")) {
+		t.Errorf("O teto deve ser o fim da última faixa com alíquota positiva. Obtido: %s", ceiling)
+	}
+
+	result := NewINSSDiscount(decimal.NewFromFloat(15000.00), config).Value()
+	if !result.Equal(decimal.NewFromFloat(988.09)) {
+		t.Errorf("Acima do teto o INSS deve ser R$ 988,09. Obtido: %s", result)
+	}
+}
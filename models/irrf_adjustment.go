@@ -0,0 +1,126 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// IRRFAdjustmentInput são os dados do cálculo disponíveis para os ajustes
+type IRRFAdjustmentInput struct {
+	GrossPay           decimal.Decimal
+	TaxableBase        decimal.Decimal
+	NumberOfDependents int64
+}
+
+// IRRFAdjustment altera o imposto calculado pela tabela progressiva, como
+// reduções, novas faixas de isenção e créditos transitórios. Os ajustes são
+// aplicados em sequência e o imposto final nunca fica negativo.
+type IRRFAdjustment interface {
+	Name() string
+	Adjust(tax decimal.Decimal, input IRRFAdjustmentInput) decimal.Decimal
+}
+
+// IRRFAdjustmentFactory cria um ajuste a partir dos parâmetros em JSON
+type IRRFAdjustmentFactory func(params json.RawMessage) (IRRFAdjustment, error)
+
+var irrfAdjustmentFactories = map[string]IRRFAdjustmentFactory{}
+
+// RegisterIRRFAdjustment registra um tipo de ajuste que pode ser usado em
+// irrf_adjustments. Deve ser chamada na inicialização do pacote.
+func RegisterIRRFAdjustment(adjustmentType string, factory IRRFAdjustmentFactory) {
+	if _, exists := irrfAdjustmentFactories[adjustmentType]; exists {
+		panic(fmt.Sprintf("IRRF adjustment %q already registered", adjustmentType))
+	}
+	irrfAdjustmentFactories[adjustmentType] = factory
+}
+
+// RegisteredIRRFAdjustments lista os tipos de ajuste registrados
+func RegisteredIRRFAdjustments() []string {
+	types := make([]string, 0, len(irrfAdjustmentFactories))
+	for adjustmentType := range irrfAdjustmentFactories {
+		types = append(types, adjustmentType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// IRRFAdjustmentSpec é a configuração de um ajuste em uma tabela:
+// {"type": "...", "params": {...}}
+type IRRFAdjustmentSpec struct {
+	Type       string          `json:"type"`
+	Params     json.RawMessage `json:"params,omitempty" swaggertype:"object"`
+	Adjustment IRRFAdjustment  `json:"-"`
+}
+
+func NewIRRFAdjustmentSpec(adjustmentType string, params interface{}) (IRRFAdjustmentSpec, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return IRRFAdjustmentSpec{}, err
+	}
+	return newIRRFAdjustmentSpec(adjustmentType, data)
+}
+
+func newIRRFAdjustmentSpec(adjustmentType string, params json.RawMessage) (IRRFAdjustmentSpec, error) {
+	factory, found := irrfAdjustmentFactories[adjustmentType]
+	if !found {
+		return IRRFAdjustmentSpec{}, fmt.Errorf("unknown IRRF adjustment type %q", adjustmentType)
+	}
+	adjustment, err := factory(params)
+	if err != nil {
+		return IRRFAdjustmentSpec{}, fmt.Errorf("IRRF adjustment %q: %w", adjustmentType, err)
+	}
+	return IRRFAdjustmentSpec{Type: adjustmentType, Params: params, Adjustment: adjustment}, nil
+}
+
+type irrfAdjustmentSpecJSON struct {
+	Type   string          `json:"type"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+func (s IRRFAdjustmentSpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(irrfAdjustmentSpecJSON{Type: s.Type, Params: s.Params})
+}
+
+func (s *IRRFAdjustmentSpec) UnmarshalJSON(data []byte) error {
+	var raw irrfAdjustmentSpecJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	spec, err := newIRRFAdjustmentSpec(raw.Type, raw.Params)
+	if err != nil {
+		return err
+	}
+	*s = spec
+	return nil
+}
+
+// decodeIRRFAdjustment decodifica os parâmetros e, se o ajuste tiver regras
+// próprias, valida os valores
+func decodeIRRFAdjustment[T IRRFAdjustment](params json.RawMessage, adjustment T) (IRRFAdjustment, error) {
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, adjustment); err != nil {
+			return nil, err
+		}
+	}
+	if validator, ok := IRRFAdjustment(adjustment).(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return adjustment, nil
+}
+
+func init() {
+	RegisterIRRFAdjustment("lei_15270_2025", func(params json.RawMessage) (IRRFAdjustment, error) {
+		return decodeIRRFAdjustment(params, &IRRFReduction{})
+	})
+	RegisterIRRFAdjustment("exemption", func(params json.RawMessage) (IRRFAdjustment, error) {
+		return decodeIRRFAdjustment(params, &IRRFExemption{})
+	})
+	RegisterIRRFAdjustment("tax_credit", func(params json.RawMessage) (IRRFAdjustment, error) {
+		return decodeIRRFAdjustment(params, &IRRFTaxCredit{})
+	})
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestIRRFAdjustments_Pipeline testa ajustes adicionais aplicados em sequência
+// depois da redução da Lei nº 15.270/2025
+func TestIRRFAdjustments_Pipeline(t *testing.T) {
+	var adjustments []IRRFAdjustmentSpec
+	data := `[
+		{"type": "exemption", "params": {"up_to": "6000.00"}},
+		{"type": "tax_credit", "params": {"amount": "50.00"}}
+	]`
+	if err := json.Unmarshal([]byte(data), &adjustments); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	config := newTaxConfig2026()
+	config.IRRFAdjustments = adjustments
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		grossPay float64
+		expected float64
+	}{
+		// Sem os ajustes adicionais o imposto seria R$ 394,54
+		{"Rendimento dentro da nova isenção", 6000.00, 0.00},
+		// Sem o crédito o imposto seria R$ 1.124,29
+		{"Rendimento com crédito", 8000.00, 1074.29},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := NewIRRFDiscount(decimal.NewFromFloat(tc.grossPay), 0, decimal.Zero, config).Value()
+			if !result.Equal(decimal.NewFromFloat(tc.expected)) {
+				t.Errorf("%s: IRRF esperado %.2f, obtido %s", tc.name, tc.expected, result)
+			}
+		})
+	}
+}
+
+// TestIRRFAdjustmentSpec_Errors testa a rejeição de ajustes desconhecidos ou inválidos
+func TestIRRFAdjustmentSpec_Errors(t *testing.T) {
+	testCases := []string{
+		`{"type": "unknown"}`,
+		`{"type": "tax_credit", "params": {"amount": "-10"}}`,
+		`{"type": "lei_15270_2025", "params": {"threshold": "8000", "upper_limit": "7350"}}`,
+	}
+
+	for _, data := range testCases {
+		var spec IRRFAdjustmentSpec
+		if err := json.Unmarshal([]byte(data), &spec); err == nil {
+			t.Errorf("O ajuste %s deveria ser rejeitado", data)
+		}
+	}
+}
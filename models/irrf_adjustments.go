@@ -0,0 +1,102 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// IRRFReduction agrupa os parâmetros da redução do IRRF da Lei nº 15.270/2025
+type IRRFReduction struct {
+	MaxAmount  decimal.Decimal `json:"max_amount"`
+	Threshold  decimal.Decimal `json:"threshold"`
+	UpperLimit decimal.Decimal `json:"upper_limit"`
+	Constant   decimal.Decimal `json:"constant"`
+	Multiplier decimal.Decimal `json:"multiplier"`
+}
+
+func (r *IRRFReduction) Name() string {
+	return "Redução Lei nº 15.270/2025"
+}
+
+func (r *IRRFReduction) Adjust(tax decimal.Decimal, input IRRFAdjustmentInput) decimal.Decimal {
+	return tax.Sub(r.calculateReduction(input.GrossPay, tax))
+}
+
+// calculateReduction calcula a redução do imposto conforme a Lei nº 15.270/2025
+// Regras:
+// - Até R$ 5.000,00: redução de até R$ 312,89 (limitado ao imposto calculado)
+// - Entre R$ 5.000,01 e R$ 7.350,00: redução gradual usando fórmula: R$ 978,62 - (0,133145 x rendimento)
+// - Acima de R$ 7.350,00: sem redução
+func (r *IRRFReduction) calculateReduction(grossPay, calculatedTax decimal.Decimal) decimal.Decimal {
+	// Acima de R$ 7.350,00: sem redução
+	if grossPay.GreaterThan(r.UpperLimit) {
+		return decimal.Zero
+	}
+
+	var reduction decimal.Decimal
+
+	// Até R$ 5.000,00: redução máxima de R$ 312,89
+	if grossPay.LessThanOrEqual(r.Threshold) {
+		reduction = r.MaxAmount
+	} else {
+		// Entre R$ 5.000,01 e R$ 7.350,00: redução gradual
+		// Fórmula: R$ 978,62 - (0,133145 x rendimento)
+		reduction = r.Constant.Sub(r.Multiplier.Mul(grossPay))
+
+		// Garantir que a redução não seja negativa
+		if reduction.LessThan(decimal.Zero) {
+			reduction = decimal.Zero
+		}
+	}
+
+	// A redução não pode ser maior que o imposto calculado
+	if reduction.GreaterThan(calculatedTax) {
+		return calculatedTax
+	}
+
+	return reduction
+}
+
+// IRRFExemption isenta do imposto rendimentos até o limite informado
+type IRRFExemption struct {
+	UpTo decimal.Decimal `json:"up_to"`
+}
+
+func (e *IRRFExemption) Name() string {
+	return "Isenção"
+}
+
+func (e *IRRFExemption) Adjust(tax decimal.Decimal, input IRRFAdjustmentInput) decimal.Decimal {
+	if input.GrossPay.LessThanOrEqual(e.UpTo) {
+		return decimal.Zero
+	}
+	return tax
+}
+
+func (e *IRRFExemption) Validate() error {
+	if e.UpTo.IsNegative() {
+		return errors.New("up_to must not be negative")
+	}
+	return nil
+}
+
+// IRRFTaxCredit abate um valor fixo do imposto, limitado ao imposto devido
+type IRRFTaxCredit struct {
+	Amount decimal.Decimal `json:"amount"`
+}
+
+func (c *IRRFTaxCredit) Name() string {
+	return "Crédito"
+}
+
+func (c *IRRFTaxCredit) Adjust(tax decimal.Decimal, input IRRFAdjustmentInput) decimal.Decimal {
+	return decimal.Max(tax.Sub(c.Amount), decimal.Zero)
+}
+
+func (c *IRRFTaxCredit) Validate() error {
+	if c.Amount.IsNegative() {
+		return errors.New("amount must not be negative")
+	}
+	return nil
+}
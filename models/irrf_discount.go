@@ -1,39 +1,14 @@
 package models
 
 import (
-	"encoding/json"
-	"os"
-
 	"github.com/shopspring/decimal"
-
-	_ "github.com/joho/godotenv/autoload"
-)
-
-var (
-	DEPENDENT_DEDUCTION_AMOUNT           = os.Getenv("DEPENDENT_DEDUCTION_AMOUNT")
-	dependentDeductionAmount, _          = decimal.NewFromString(DEPENDENT_DEDUCTION_AMOUNT)
-	IRRFRanges                           = loadIRRFRangesFromEnv()
-	IRRF_SIMPLIFIED_DEDUCTION_PERCENTAGE = getEnvOrDefault("IRRF_SIMPLIFIED_DEDUCTION_PERCENTAGE", "0.25")
-	simplifiedDeductionPercentage, _     = decimal.NewFromString(IRRF_SIMPLIFIED_DEDUCTION_PERCENTAGE)
-
-	// Nova regra de redução do IRRF 2026 (Lei nº 15.270/2025)
-	// Ampliação da faixa de isenção para rendimentos até R$ 5.000,00
-	IRRF_MAX_REDUCTION_AMOUNT  = getEnvOrDefault("IRRF_MAX_REDUCTION_AMOUNT", "312.89")
-	maxReductionAmount, _      = decimal.NewFromString(IRRF_MAX_REDUCTION_AMOUNT)
-	IRRF_REDUCTION_THRESHOLD   = getEnvOrDefault("IRRF_REDUCTION_THRESHOLD", "5000.00")
-	reductionThreshold, _      = decimal.NewFromString(IRRF_REDUCTION_THRESHOLD)
-	IRRF_REDUCTION_UPPER_LIMIT = getEnvOrDefault("IRRF_REDUCTION_UPPER_LIMIT", "7350.00")
-	reductionUpperLimit, _     = decimal.NewFromString(IRRF_REDUCTION_UPPER_LIMIT)
-	IRRF_REDUCTION_CONSTANT    = getEnvOrDefault("IRRF_REDUCTION_CONSTANT", "978.62")
-	reductionConstant, _       = decimal.NewFromString(IRRF_REDUCTION_CONSTANT)
-	IRRF_REDUCTION_MULTIPLIER  = getEnvOrDefault("IRRF_REDUCTION_MULTIPLIER", "0.133145")
-	reductionMultiplier, _     = decimal.NewFromString(IRRF_REDUCTION_MULTIPLIER)
 )
 
 type IRRFDiscount struct {
 	GrossPay            decimal.Decimal
 	NumberOfDependents  int64
 	INSSDeductionAmount decimal.Decimal
+	Config              *TaxConfig
 }
 
 type IRRFRange struct {
@@ -43,11 +18,12 @@ type IRRFRange struct {
 	Deduction     decimal.Decimal `json:"deduction"`
 }
 
-func NewIRRFDiscount(grossPay decimal.Decimal, numberOfDependents int64, inssDeductionAmount decimal.Decimal) *IRRFDiscount {
+func NewIRRFDiscount(grossPay decimal.Decimal, numberOfDependents int64, inssDeductionAmount decimal.Decimal, config *TaxConfig) *IRRFDiscount {
 	return &IRRFDiscount{
 		GrossPay:            grossPay,
 		NumberOfDependents:  numberOfDependents,
 		INSSDeductionAmount: inssDeductionAmount,
+		Config:              config,
 	}
 }
 
@@ -60,34 +36,16 @@ func NewIRRFRange(startingValue, endingValue, aliquot, deduction decimal.Decimal
 	}
 }
 
-func loadIRRFRangesFromEnv() []IRRFRange {
-	data := os.Getenv("IRRF_RANGES")
-	var irrfRanges []IRRFRange
-	if err := json.Unmarshal([]byte(data), &irrfRanges); err != nil {
-		// Consider logging the error or handling it appropriately
-		return nil
-	}
-	return irrfRanges
-}
-
-// getEnvOrDefault retorna o valor da variável de ambiente ou um valor padrão se não estiver definida
-func getEnvOrDefault(key, defaultValue string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		return defaultValue
-	}
-	return value
-}
-
 func (i *IRRFDiscount) dependentsDeduction() decimal.Decimal {
-	return dependentDeductionAmount.Mul(decimal.NewFromInt(i.NumberOfDependents))
+	return i.Config.DependentDeduction.Mul(decimal.NewFromInt(i.NumberOfDependents))
 }
 
 func (i *IRRFDiscount) simplifiedDeductionAmount() decimal.Decimal {
-	if len(IRRFRanges) == 0 {
+	ranges := i.Config.IRRFRanges
+	if len(ranges) == 0 {
 		return decimal.Zero
 	}
-	return IRRFRanges[0].EndingValue.Mul(simplifiedDeductionPercentage)
+	return ranges[0].EndingValue.Mul(i.Config.SimplifiedDeductionPercentage)
 }
 
 // totalDeductionWithDependents calcula a dedução usando dependentes + INSS
@@ -135,9 +93,16 @@ func (i *IRRFDiscount) calculateIRRFWithDeduction(deduction decimal.Decimal) dec
 	taxableBaseProduct := taxableBase.Mul(matchingRange.Aliquot)
 	calculatedTax := taxableBaseProduct.Sub(matchingRange.Deduction)
 
-	// Aplica a redução conforme a Lei nº 15.270/2025
-	reduction := i.calculateReduction(calculatedTax)
-	finalTax := calculatedTax.Sub(reduction)
+	// Aplica os ajustes da tabela, como a redução da Lei nº 15.270/2025
+	finalTax := calculatedTax
+	input := IRRFAdjustmentInput{
+		GrossPay:           i.GrossPay,
+		TaxableBase:        taxableBase,
+		NumberOfDependents: i.NumberOfDependents,
+	}
+	for _, adjustment := range i.Config.IRRFAdjustmentPipeline() {
+		finalTax = adjustment.Adjust(finalTax, input)
+	}
 
 	// Garante que o imposto final não seja negativo
 	if finalTax.LessThan(decimal.Zero) {
@@ -148,49 +113,22 @@ func (i *IRRFDiscount) calculateIRRFWithDeduction(deduction decimal.Decimal) dec
 }
 
 func (i *IRRFDiscount) findMatchingRangeForBase(taxBase decimal.Decimal) *IRRFRange {
-	for _, irrfRange := range IRRFRanges {
-		if taxBase.GreaterThanOrEqual(irrfRange.StartingValue) && taxBase.LessThanOrEqual(irrfRange.EndingValue) {
-			return &irrfRange
-		}
-	}
-	return nil
+	return findIRRFRange(i.Config.IRRFRanges, taxBase)
 }
 
-// calculateReduction calcula a redução do imposto conforme a Lei nº 15.270/2025
-// Regras:
-// - Até R$ 5.000,00: redução de até R$ 312,89 (limitado ao imposto calculado)
-// - Entre R$ 5.000,01 e R$ 7.350,00: redução gradual usando fórmula: R$ 978,62 - (0,133145 x rendimento)
-// - Acima de R$ 7.350,00: sem redução
-func (i *IRRFDiscount) calculateReduction(calculatedTax decimal.Decimal) decimal.Decimal {
-	grossPay := i.GrossPay
-
-	// Acima de R$ 7.350,00: sem redução
-	if grossPay.GreaterThan(reductionUpperLimit) {
-		return decimal.Zero
-	}
-
-	var reduction decimal.Decimal
-
-	// Até R$ 5.000,00: redução máxima de R$ 312,89
-	if grossPay.LessThanOrEqual(reductionThreshold) {
-		reduction = maxReductionAmount
-	} else {
-		// Entre R$ 5.000,01 e R$ 7.350,00: redução gradual
-		// Fórmula: R$ 978,62 - (0,133145 x rendimento)
-		reduction = reductionConstant.Sub(reductionMultiplier.Mul(grossPay))
-
-		// Garantir que a redução não seja negativa
-		if reduction.LessThan(decimal.Zero) {
-			reduction = decimal.Zero
+// findIRRFRange retorna a faixa da tabela progressiva que contém a base. A
+// última faixa é aberta: acima do seu limite vale a maior alíquota, mesmo que
+// a tabela informe um valor final finito.
+func findIRRFRange(ranges []IRRFRange, taxBase decimal.Decimal) *IRRFRange {
+	for _, irrfRange := range ranges {
+		if taxBase.GreaterThanOrEqual(irrfRange.StartingValue) && taxBase.LessThanOrEqual(irrfRange.EndingValue) {
+			return &irrfRange
 		}
 	}
-
-	// A redução não pode ser maior que o imposto calculado
-	if reduction.GreaterThan(calculatedTax) {
-		return calculatedTax
+	if len(ranges) > 0 && taxBase.GreaterThan(ranges[len(ranges)-1].EndingValue) {
+		return &ranges[len(ranges)-1]
 	}
-
-	return reduction
+	return nil
 }
 
 func (i *IRRFDiscount) Name() string {
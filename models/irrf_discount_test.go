@@ -1,30 +1,12 @@
 package models
 
 import (
-	"os"
 	"testing"
 
 	"github.com/shopspring/decimal"
 )
 
-func init() {
-	// Configurar variáveis de ambiente necessárias para os testes
-	os.Setenv("DEPENDENT_DEDUCTION_AMOUNT", "189.59")
-
-	// Tabela progressiva mensal do IRRF 2026 (oficial da Receita Federal)
-	irrfRangesJSON := `[
-		{"init_value": "0.00", "end_value": "2428.80", "aliquot": "0.00", "deduction": "0.00"},
-		{"init_value": "2428.81", "end_value": "2826.65", "aliquot": "0.075", "deduction": "182.16"},
-		{"init_value": "2826.66", "end_value": "3751.05", "aliquot": "0.15", "deduction": "394.16"},
-		{"init_value": "3751.06", "end_value": "4664.68", "aliquot": "0.225", "deduction": "675.49"},
-		{"init_value": "4664.69", "end_value": "999999999.99", "aliquot": "0.275", "deduction": "908.73"}
-	]`
-	os.Setenv("IRRF_RANGES", irrfRangesJSON)
-
-	// Recarregar as variáveis
-	dependentDeductionAmount, _ = decimal.NewFromString(os.Getenv("DEPENDENT_DEDUCTION_AMOUNT"))
-	IRRFRanges = loadIRRFRangesFromEnv()
-}
+var testTaxConfig = newTaxConfig2026()
 
 // TestIRRFExample1_Receita_4500 testa o exemplo 1 da Receita Federal
 // Rendimento: R$ 4.500,00
@@ -34,7 +16,7 @@ func TestIRRFExample1_Receita_4500(t *testing.T) {
 	grossPay := decimal.NewFromFloat(4500.00)
 	inssDeduction := decimal.Zero
 
-	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction)
+	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction, testTaxConfig)
 	result := irrf.Value()
 
 	expected := decimal.Zero
@@ -49,7 +31,7 @@ func TestIRRFExample2_Receita_6000(t *testing.T) {
 	grossPay := decimal.NewFromFloat(6000.00)
 	inssDeduction := decimal.Zero
 
-	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction)
+	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction, testTaxConfig)
 	result := irrf.Value()
 
 	// Verificar que o imposto está sendo calculado e que há redução
@@ -71,7 +53,7 @@ func TestIRRFReduction_LimitAt5000(t *testing.T) {
 	grossPay := decimal.NewFromFloat(5000.00)
 	inssDeduction := decimal.Zero
 
-	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction)
+	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction, testTaxConfig)
 	result := irrf.Value()
 
 	maxExpected := decimal.NewFromFloat(100.00)
@@ -88,7 +70,7 @@ func TestIRRFReduction_NoReductionAbove7350(t *testing.T) {
 	grossPay := decimal.NewFromFloat(8000.00)
 	inssDeduction := decimal.Zero
 
-	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction)
+	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction, testTaxConfig)
 	result := irrf.Value()
 
 	// Acima de R$ 7.350,00 não deve ter redução
@@ -117,7 +99,7 @@ func TestIRRFReduction_GradualBetween5000And7350(t *testing.T) {
 			grossPay := decimal.NewFromFloat(tc.grossPay)
 			inssDeduction := decimal.Zero
 
-			irrf := NewIRRFDiscount(grossPay, 0, inssDeduction)
+			irrf := NewIRRFDiscount(grossPay, 0, inssDeduction, testTaxConfig)
 			result := irrf.Value()
 
 			maxExpected := decimal.NewFromFloat(tc.maxImposto)
@@ -138,7 +120,7 @@ func TestIRRFWithDependents(t *testing.T) {
 	inssDeduction := decimal.Zero
 	numberOfDependents := int64(2)
 
-	irrf := NewIRRFDiscount(grossPay, numberOfDependents, inssDeduction)
+	irrf := NewIRRFDiscount(grossPay, numberOfDependents, inssDeduction, testTaxConfig)
 	result := irrf.Value()
 
 	// Com dependentes, a base de cálculo diminui, então o imposto deve ser menor
@@ -152,7 +134,7 @@ func TestIRRFWithINSSDeduction(t *testing.T) {
 	grossPay := decimal.NewFromFloat(5000.00)
 	inssDeduction := decimal.NewFromFloat(550.00) // 11% de R$ 5.000,00
 
-	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction)
+	irrf := NewIRRFDiscount(grossPay, 0, inssDeduction, testTaxConfig)
 	result := irrf.Value()
 
 	// Com dedução do INSS, a base de cálculo diminui, então o imposto deve ser menor
@@ -161,6 +143,21 @@ func TestIRRFWithINSSDeduction(t *testing.T) {
 	}
 }
 
+// TestIRRFAboveLastRange testa uma tabela cuja última faixa tem valor final
+// finito: acima dele o IRRF continua na maior alíquota, em vez de zerar
+func TestIRRFAboveLastRange(t *testing.T) {
+	config := newTaxConfig2026()
+	config.IRRFRanges[len(config.IRRFRanges)-1].EndingValue = decimal.NewFromFloat(10000.00)
+
+	result := NewIRRFDiscount(decimal.NewFromFloat(20000.00), 0, decimal.Zero, config).Value()
+
+	// (20.000,00 - 607,20) × 27,5% - 908,73
+	expected := decimal.NewFromFloat(4424.29)
+	if !result.Equal(expected) {
+		t.Errorf("Para rendimento de R$ 20.000,00 acima da última faixa, o IRRF deve ser %s. Obtido: %s", expected, result)
+	}
+}
+
 // TestIRRFCalculateReduction testa a função calculateReduction isoladamente
 func TestIRRFCalculateReduction(t *testing.T) {
 	testCases := []struct {
@@ -201,8 +198,8 @@ func TestIRRFCalculateReduction(t *testing.T) {
 			calculatedTax := decimal.NewFromFloat(tc.calculatedTax)
 			expectedReduction := decimal.NewFromFloat(tc.expectedReduction)
 
-			irrf := NewIRRFDiscount(grossPay, 0, decimal.Zero)
-			reduction := irrf.calculateReduction(calculatedTax)
+			irrf := NewIRRFDiscount(grossPay, 0, decimal.Zero, testTaxConfig)
+			reduction := irrf.Config.IRRFReduction.calculateReduction(irrf.GrossPay, calculatedTax)
 
 			tolerance := decimal.NewFromFloat(0.10)
 			diff := reduction.Sub(expectedReduction).Abs()
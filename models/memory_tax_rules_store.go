@@ -0,0 +1,68 @@
+package models
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MemoryTaxRulesStore mantém as versões em memória, na ordem de publicação.
+// As versões se perdem a cada reinício e não são compartilhadas entre
+// réplicas; para publicar com antecedência use FileTaxRulesStore.
+type MemoryTaxRulesStore struct {
+	mu       sync.RWMutex
+	versions []*TaxRules
+	active   string
+}
+
+func NewMemoryTaxRulesStore() *MemoryTaxRulesStore {
+	return &MemoryTaxRulesStore{}
+}
+
+func (s *MemoryTaxRulesStore) List() ([]*TaxRules, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	versions := make([]*TaxRules, len(s.versions))
+	copy(versions, s.versions)
+	return versions, nil
+}
+
+func (s *MemoryTaxRulesStore) Get(version string) (*TaxRules, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, rules := range s.versions {
+		if rules.Version == version {
+			return rules, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrTaxRulesVersionNotFound, version)
+}
+
+func (s *MemoryTaxRulesStore) Save(rules *TaxRules) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.versions {
+		if existing.Version == rules.Version {
+			return fmt.Errorf("%w: %q", ErrTaxRulesVersionExists, rules.Version)
+		}
+	}
+	s.versions = append(s.versions, rules)
+	return nil
+}
+
+func (s *MemoryTaxRulesStore) SetActive(version string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.active = version
+	return nil
+}
+
+func (s *MemoryTaxRulesStore) Active() (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.active, nil
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// DefaultNightShiftPremium é o adicional noturno mínimo do trabalhador urbano
+	DefaultNightShiftPremium = decimal.NewFromFloat(0.2)
+	minutesPerHour           = decimal.NewFromInt(60)
+	// nightHourMinutes é a duração da hora noturna legal, 52 minutos e 30 segundos
+	nightHourMinutes = decimal.NewFromFloat(52.5)
+)
+
+// NightShiftPremium é o adicional noturno sobre as horas trabalhadas entre
+// 22h e 5h. As horas de relógio são convertidas em horas noturnas legais antes
+// de aplicar o adicional sobre o valor da hora normal.
+type NightShiftPremium struct {
+	monthlySalary decimal.Decimal
+	monthlyHours  decimal.Decimal
+	clockHours    decimal.Decimal
+	premium       decimal.Decimal
+}
+
+func NewNightShiftPremium(monthlySalary, monthlyHours, clockHours, premium decimal.Decimal) *NightShiftPremium {
+	return &NightShiftPremium{
+		monthlySalary: monthlySalary,
+		monthlyHours:  monthlyHours,
+		clockHours:    clockHours,
+		premium:       premium,
+	}
+}
+
+// LegalHours são as horas de relógio convertidas pela hora noturna reduzida
+func (n NightShiftPremium) LegalHours() decimal.Decimal {
+	return n.clockHours.Mul(minutesPerHour).Div(nightHourMinutes)
+}
+
+func (n NightShiftPremium) Value() decimal.Decimal {
+	return HourlyRate(n.monthlySalary, n.monthlyHours).Mul(n.premium).Mul(n.LegalHours()).RoundBank(2)
+}
+
+func (n NightShiftPremium) Name() string {
+	return fmt.Sprintf("Adicional noturno %s%%", n.premium.Shift(2))
+}
+
+func (n NightShiftPremium) Incidence() Incidence {
+	return FullIncidence
+}
+
+// NightShiftEarnings monta o adicional noturno e o reflexo dele no DSR,
+// omitindo as linhas quando não há horas noturnas
+func NightShiftEarnings(monthlySalary, monthlyHours, clockHours, premium decimal.Decimal, month WorkMonth) []Earning {
+	if !clockHours.IsPositive() {
+		return nil
+	}
+	premiumEarning := NewNightShiftPremium(monthlySalary, monthlyHours, clockHours, premium)
+	return []Earning{
+		premiumEarning,
+		NewDSRReflection("DSR sobre adicional noturno", month, premiumEarning),
+	}
+}
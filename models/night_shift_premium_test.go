@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestNightShiftEarnings testa a hora noturna reduzida, o adicional e o DSR
+// Salário R$ 2.200,00 com 220 horas mensais: hora normal de R$ 10,00
+// 35 horas de relógio equivalem a 40 horas noturnas de 52m30s
+func TestNightShiftEarnings(t *testing.T) {
+	earnings := NightShiftEarnings(
+		decimal.NewFromFloat(2200.00),
+		decimal.NewFromInt(220),
+		decimal.NewFromInt(35),
+		DefaultNightShiftPremium,
+		WorkMonth{BusinessDays: 25, RestDays: 5},
+	)
+
+	expected := []struct {
+		name  string
+		value float64
+	}{
+		{"Adicional noturno 20%", 80.00},
+		{"DSR sobre adicional noturno", 16.00},
+	}
+	if len(earnings) != len(expected) {
+		t.Fatalf("Esperados %d proventos, obtidos %d", len(expected), len(earnings))
+	}
+	for i, e := range expected {
+		if earnings[i].Name() != e.name {
+			t.Errorf("Provento %d: nome esperado %q, obtido %q", i, e.name, earnings[i].Name())
+		}
+		if !earnings[i].Value().Equal(decimal.NewFromFloat(e.value)) {
+			t.Errorf("%s: valor esperado %.2f, obtido %s", e.name, e.value, earnings[i].Value())
+		}
+	}
+}
+
+// TestNightShiftPremium_LegalHours testa a conversão de uma jornada de 22h às 5h
+func TestNightShiftPremium_LegalHours(t *testing.T) {
+	premium := NewNightShiftPremium(decimal.NewFromFloat(2200.00), decimal.NewFromInt(220), decimal.NewFromInt(7), DefaultNightShiftPremium)
+
+	if !premium.LegalHours().Equal(decimal.NewFromInt(8)) {
+		t.Errorf("7 horas de relógio devem equivaler a 8 horas noturnas, obtido %s", premium.LegalHours())
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// OvertimePremium50 é o adicional das horas extras em dias úteis
+	OvertimePremium50 = decimal.NewFromFloat(0.5)
+	// OvertimePremium100 é o adicional das horas extras em domingos e feriados
+	OvertimePremium100 = decimal.NewFromInt(1)
+)
+
+// Overtime é o provento das horas extras com um adicional sobre o valor da
+// hora normal, que é o salário mensal dividido pela jornada mensal contratual
+type Overtime struct {
+	monthlySalary decimal.Decimal
+	monthlyHours  decimal.Decimal
+	hours         decimal.Decimal
+	premium       decimal.Decimal
+}
+
+func NewOvertime(monthlySalary, monthlyHours, hours, premium decimal.Decimal) *Overtime {
+	return &Overtime{
+		monthlySalary: monthlySalary,
+		monthlyHours:  monthlyHours,
+		hours:         hours,
+		premium:       premium,
+	}
+}
+
+// HourlyRate é o valor da hora normal
+func (o Overtime) HourlyRate() decimal.Decimal {
+	return HourlyRate(o.monthlySalary, o.monthlyHours)
+}
+
+func (o Overtime) Value() decimal.Decimal {
+	return o.HourlyRate().Mul(one.Add(o.premium)).Mul(o.hours).RoundBank(2)
+}
+
+func (o Overtime) Name() string {
+	return fmt.Sprintf("Horas extras %s%%", o.premium.Shift(2))
+}
+
+func (o Overtime) Incidence() Incidence {
+	return FullIncidence
+}
+
+// OvertimeEarnings monta as horas extras a 50% e a 100% e o reflexo delas no
+// DSR, omitindo as linhas sem horas
+func OvertimeEarnings(monthlySalary, monthlyHours, hours50, hours100 decimal.Decimal, month WorkMonth) []Earning {
+	var overtime []Earning
+	if hours50.IsPositive() {
+		overtime = append(overtime, NewOvertime(monthlySalary, monthlyHours, hours50, OvertimePremium50))
+	}
+	if hours100.IsPositive() {
+		overtime = append(overtime, NewOvertime(monthlySalary, monthlyHours, hours100, OvertimePremium100))
+	}
+	if len(overtime) == 0 {
+		return nil
+	}
+	return append(overtime, NewDSRReflection("DSR sobre horas extras", month, overtime...))
+}
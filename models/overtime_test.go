@@ -0,0 +1,91 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestOvertimeEarnings testa as horas extras a 50% e 100% e o reflexo no DSR
+// Salário R$ 2.200,00 com 220 horas mensais: hora normal de R$ 10,00
+func TestOvertimeEarnings(t *testing.T) {
+	month, err := NewWorkMonth(25, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	earnings := OvertimeEarnings(
+		decimal.NewFromFloat(2200.00),
+		decimal.NewFromInt(220),
+		decimal.NewFromInt(10),
+		decimal.NewFromInt(4),
+		month,
+	)
+
+	expected := []struct {
+		name  string
+		value float64
+	}{
+		{"Horas extras 50%", 150.00},
+		{"Horas extras 100%", 80.00},
+		{"DSR sobre horas extras", 46.00},
+	}
+	if len(earnings) != len(expected) {
+		t.Fatalf("Esperados %d proventos, obtidos %d", len(expected), len(earnings))
+	}
+	for i, e := range expected {
+		if earnings[i].Name() != e.name {
+			t.Errorf("Provento %d: nome esperado %q, obtido %q", i, e.name, earnings[i].Name())
+		}
+		if !earnings[i].Value().Equal(decimal.NewFromFloat(e.value)) {
+			t.Errorf("%s: valor esperado %.2f, obtido %s", e.name, e.value, earnings[i].Value())
+		}
+	}
+}
+
+// TestOvertimeEarnings_NoHours testa que sem horas extras não há proventos
+func TestOvertimeEarnings_NoHours(t *testing.T) {
+	earnings := OvertimeEarnings(decimal.NewFromFloat(2200.00), decimal.NewFromInt(220), decimal.Zero, decimal.Zero, WorkMonth{BusinessDays: 25, RestDays: 5})
+	if len(earnings) != 0 {
+		t.Errorf("Sem horas extras não deve haver proventos, obtidos %d", len(earnings))
+	}
+}
+
+// TestPayroll_OvertimeEnlargesBases testa que as horas extras entram nas bases do INSS e do IRRF
+func TestPayroll_OvertimeEnlargesBases(t *testing.T) {
+	salary := decimal.NewFromFloat(4000.00)
+	earnings := append([]Earning{NewBaseSalary(salary)},
+		OvertimeEarnings(salary, decimal.NewFromInt(220), decimal.NewFromInt(10), decimal.Zero, WorkMonth{BusinessDays: 25, RestDays: 5})...)
+
+	payroll := NewPayrollFromEarnings(earnings, 0, newTaxConfig2025())
+
+	// 4000 / 220 * 1,5 * 10 = 272,73 e DSR de 272,73 / 25 * 5 = 54,55
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"Base do INSS", payroll.INSSBase(), 4327.28},
+		{"Base do IRRF", payroll.IRRFBase(), 4327.28},
+		// 113,85 + 114,83 + 167,63 + 136,45 × 14%
+		{"INSS", payroll.Discounts[0].Value(), 415.41},
+		// (4.327,28 - 607,20) × 15% - 394,16
+		{"IRRF", payroll.Discounts[1].Value(), 163.85},
+		{"Líquido", payroll.NetPay(), 3748.02},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
+
+// TestWorkMonthOf testa a contagem de domingos da competência
+func TestWorkMonthOf(t *testing.T) {
+	// Março de 2026 tem 31 dias, 5 deles domingos
+	month := WorkMonthOf(NewCompetence(2026, time.March))
+	if month.BusinessDays != 26 || month.RestDays != 5 {
+		t.Errorf("Esperados 26 dias úteis e 5 de descanso, obtidos %d e %d", month.BusinessDays, month.RestDays)
+	}
+}
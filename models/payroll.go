@@ -5,26 +5,58 @@ import (
 )
 
 type Payroll struct {
-	GrossPay  decimal.Decimal
+	GrossPay decimal.Decimal
+	Earnings []Earning
+	// Benefits são os valores pagos fora do salário bruto, como o
+	// salário-família, que somam ao líquido
+	Benefits  []Earning
+	TaxConfig *TaxConfig
 	Discounts []Discount
 }
 
-func NewPayroll(grossPay decimal.Decimal, numberOfDependents int64, additionalDiscounts ...Discount) *Payroll {
+// NewPayroll calcula a folha de um salário mensal com as tabelas de INSS e
+// IRRF do TaxConfig informado, normalmente obtido com TaxRules.ConfigFor
+// para a competência
+func NewPayroll(grossPay decimal.Decimal, numberOfDependents int64, config *TaxConfig, additionalDiscounts ...Discount) *Payroll {
+	return NewPayrollFromEarnings([]Earning{NewBaseSalary(grossPay)}, numberOfDependents, config, additionalDiscounts...)
+}
+
+// NewPayrollFromEarnings calcula a folha a partir dos proventos. O salário
+// bruto é a soma dos proventos e as bases do INSS e do IRRF somam apenas os
+// proventos com a respectiva incidência.
+func NewPayrollFromEarnings(earnings []Earning, numberOfDependents int64, config *TaxConfig, additionalDiscounts ...Discount) *Payroll {
+	return newPayroll(earnings, numberOfDependents, config, employeeINSS, additionalDiscounts...)
+}
+
+// inssContribution calcula a contribuição previdenciária do segurado sobre a
+// base do INSS, que muda entre empregados e contribuintes individuais
+type inssContribution func(base decimal.Decimal, config *TaxConfig) Discount
+
+func employeeINSS(base decimal.Decimal, config *TaxConfig) Discount {
+	return NewINSSDiscount(base, config)
+}
+
+func newPayroll(earnings []Earning, numberOfDependents int64, config *TaxConfig, inss inssContribution, additionalDiscounts ...Discount) *Payroll {
 	payroll := &Payroll{
-		GrossPay:  grossPay,
+		Earnings:  earnings,
+		TaxConfig: config,
 		Discounts: make([]Discount, 0),
 	}
+	payroll.GrossPay = payroll.sumEarnings(func(Incidence) bool { return true })
 
-	payroll.addMandatoryDiscounts(numberOfDependents)
+	// Os descontos adicionais entram antes porque os que reduzem as bases
+	// (BaseReducingDiscount) afetam o INSS e o IRRF
 	payroll.addOptionalDiscounts(additionalDiscounts...)
+	payroll.addMandatoryDiscounts(numberOfDependents, inss)
 
 	return payroll
 }
 
-func (p *Payroll) addMandatoryDiscounts(numberOfDependents int64) {
-	inss := NewINSSDiscount(p.GrossPay)
-	irrf := NewIRRFDiscount(p.GrossPay, numberOfDependents, inss.Value())
-	p.Discounts = append(p.Discounts, inss, irrf)
+// addMandatoryDiscounts calcula o INSS e o IRRF e os coloca no início dos descontos
+func (p *Payroll) addMandatoryDiscounts(numberOfDependents int64, contribution inssContribution) {
+	inss := contribution(p.INSSBase(), p.TaxConfig)
+	irrf := NewIRRFDiscount(p.IRRFBase(), numberOfDependents, inss.Value(), p.TaxConfig)
+	p.Discounts = append([]Discount{inss, irrf}, p.Discounts...)
 }
 
 func (p *Payroll) addOptionalDiscounts(discounts ...Discount) {
@@ -33,8 +65,64 @@ func (p *Payroll) addOptionalDiscounts(discounts ...Discount) {
 	}
 }
 
+// INSSBase é a soma dos proventos sujeitos ao INSS menos os descontos que
+// reduzem essa base
+func (p *Payroll) INSSBase() decimal.Decimal {
+	return p.base(func(i Incidence) bool { return i.INSS })
+}
+
+// IRRFBase é a soma dos proventos sujeitos ao IRRF menos os descontos que
+// reduzem essa base
+func (p *Payroll) IRRFBase() decimal.Decimal {
+	return p.base(func(i Incidence) bool { return i.IRRF })
+}
+
+// FGTSBase é a soma dos proventos sujeitos ao FGTS menos os descontos que
+// reduzem essa base
+func (p *Payroll) FGTSBase() decimal.Decimal {
+	return p.base(func(i Incidence) bool { return i.FGTS })
+}
+
+func (p *Payroll) base(included func(Incidence) bool) decimal.Decimal {
+	base := p.sumEarnings(included)
+	for _, discount := range p.Discounts {
+		if reducing, ok := discount.(BaseReducingDiscount); ok && included(reducing.Incidence()) {
+			base = base.Sub(discount.Value())
+		}
+	}
+	return decimal.Max(base, decimal.Zero)
+}
+
+func (p *Payroll) sumEarnings(included func(Incidence) bool) decimal.Decimal {
+	total := decimal.Zero
+	for _, earning := range p.Earnings {
+		if included(earning.Incidence()) {
+			total = total.Add(earning.Value())
+		}
+	}
+	return total
+}
+
+// AddFamilyAllowance inclui o salário-família dos filhos menores de 14 anos
+// ou inválidos quando a remuneração, a base do INSS, está dentro do limite da
+// tabela vigente
+func (p *Payroll) AddFamilyAllowance(children int64) {
+	allowance := NewFamilyAllowance(p.TaxConfig.FamilyAllowance, p.INSSBase(), children)
+	if allowance.IsEligible() {
+		p.Benefits = append(p.Benefits, allowance)
+	}
+}
+
 func (p *Payroll) NetPay() decimal.Decimal {
-	return p.GrossPay.Sub(p.TotalDiscount())
+	return p.GrossPay.Add(p.TotalBenefits()).Sub(p.TotalDiscount())
+}
+
+func (p *Payroll) TotalBenefits() decimal.Decimal {
+	totalBenefits := decimal.Zero
+	for _, benefit := range p.Benefits {
+		totalBenefits = totalBenefits.Add(benefit.Value())
+	}
+	return totalBenefits
 }
 
 func (p *Payroll) TotalDiscount() decimal.Decimal {
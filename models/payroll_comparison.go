@@ -0,0 +1,66 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// PayrollComparison é o resultado da mesma folha calculada com duas tabelas
+type PayrollComparison struct {
+	Base      *Payroll
+	Target    *Payroll
+	Discounts []DiscountDifference
+}
+
+// DiscountDifference compara um desconto entre as duas folhas. Descontos que
+// existem em apenas uma delas aparecem com valor zero na outra.
+type DiscountDifference struct {
+	Name   string
+	Base   decimal.Decimal
+	Target decimal.Decimal
+}
+
+func (d DiscountDifference) Difference() decimal.Decimal {
+	return d.Target.Sub(d.Base)
+}
+
+// ComparePayrolls calcula a folha com os mesmos dados usando as tabelas base e alvo
+func ComparePayrolls(grossPay decimal.Decimal, numberOfDependents int64, base, target *TaxConfig, additionalDiscounts ...Discount) *PayrollComparison {
+	return NewPayrollComparison(
+		NewPayroll(grossPay, numberOfDependents, base, additionalDiscounts...),
+		NewPayroll(grossPay, numberOfDependents, target, additionalDiscounts...),
+	)
+}
+
+// NewPayrollComparison compara duas folhas já calculadas, normalmente com os
+// mesmos proventos e tabelas diferentes
+func NewPayrollComparison(base, target *Payroll) *PayrollComparison {
+	return &PayrollComparison{
+		Base:      base,
+		Target:    target,
+		Discounts: compareDiscounts(base.Discounts, target.Discounts),
+	}
+}
+
+func (c *PayrollComparison) NetPayDifference() decimal.Decimal {
+	return c.Target.NetPay().Sub(c.Base.NetPay())
+}
+
+func (c *PayrollComparison) TotalDiscountDifference() decimal.Decimal {
+	return c.Target.TotalDiscount().Sub(c.Base.TotalDiscount())
+}
+
+func compareDiscounts(base, target []Discount) []DiscountDifference {
+	differences := make([]DiscountDifference, 0, len(base))
+	positions := make(map[string]int)
+
+	for _, discount := range base {
+		positions[discount.Name()] = len(differences)
+		differences = append(differences, DiscountDifference{Name: discount.Name(), Base: discount.Value(), Target: decimal.Zero})
+	}
+	for _, discount := range target {
+		if i, found := positions[discount.Name()]; found {
+			differences[i].Target = discount.Value()
+			continue
+		}
+		differences = append(differences, DiscountDifference{Name: discount.Name(), Base: decimal.Zero, Target: discount.Value()})
+	}
+	return differences
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestComparePayrolls_2025x2026 testa a comparação da mesma folha antes e
+// depois da redução do IRRF de 2026
+func TestComparePayrolls_2025x2026(t *testing.T) {
+	comparison := ComparePayrolls(decimal.NewFromFloat(4000.00), 0, newTaxConfig2025(), newTaxConfig2026(),
+		NewFixedAmountDiscount(decimal.NewFromFloat(100.00)))
+
+	expected := map[string]float64{
+		"INSS":       -4.82,   // 368,59 - 373,41
+		"IRRF":       -114.76, // 0,00 - 114,76
+		"Valor fixo": 0,
+	}
+	if len(comparison.Discounts) != len(expected) {
+		t.Fatalf("Esperadas %d diferenças, obtidas %d", len(expected), len(comparison.Discounts))
+	}
+	for _, difference := range comparison.Discounts {
+		if !difference.Difference().Equal(decimal.NewFromFloat(expected[difference.Name])) {
+			t.Errorf("%s: diferença esperada %.2f, obtida %s", difference.Name, expected[difference.Name], difference.Difference())
+		}
+	}
+
+	if !comparison.NetPayDifference().Equal(decimal.NewFromFloat(119.58)) {
+		t.Errorf("O salário líquido deve aumentar R$ 119,58. Obtido: %s", comparison.NetPayDifference())
+	}
+}
+
+// TestNewPayrollComparison_HourlyEarnings testa a comparação de folhas já
+// calculadas com os mesmos proventos, como a do endpoint de comparação.
+// R$ 15,00 por hora e 176 horas com DSR, bruto de R$ 3.600,00.
+func TestNewPayrollComparison_HourlyEarnings(t *testing.T) {
+	earnings := HourlyPayEarnings(decimal.NewFromFloat(15.00), decimal.NewFromInt(176), WorkMonth{BusinessDays: 22, RestDays: 8})
+	comparison := NewPayrollComparison(
+		NewPayrollFromEarnings(earnings, 0, newTaxConfig2025()),
+		NewPayrollFromEarnings(earnings, 0, newTaxConfig2026()),
+	)
+
+	expected := map[string]float64{
+		"INSS": -4.82,  // 320,59 - 325,41
+		"IRRF": -54.76, // 0,00 - 54,76
+	}
+	if len(comparison.Discounts) != len(expected) {
+		t.Fatalf("Esperadas %d diferenças, obtidas %d", len(expected), len(comparison.Discounts))
+	}
+	for _, difference := range comparison.Discounts {
+		if !difference.Difference().Equal(decimal.NewFromFloat(expected[difference.Name])) {
+			t.Errorf("%s: diferença esperada %.2f, obtida %s", difference.Name, expected[difference.Name], difference.Difference())
+		}
+	}
+
+	if !comparison.NetPayDifference().Equal(decimal.NewFromFloat(59.58)) {
+		t.Errorf("O salário líquido deve aumentar R$ 59,58. Obtido: %s", comparison.NetPayDifference())
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestPayroll_RegimesSideBySide testa o cálculo de 2025 e 2026 em paralelo no
+// mesmo processo, cada um com seu próprio TaxConfig
+func TestPayroll_RegimesSideBySide(t *testing.T) {
+	testCases := []struct {
+		name         string
+		config       *TaxConfig
+		expectedINSS float64
+		expectedIRRF float64
+	}{
+		{"Competência 2025", newTaxConfig2025(), 373.41, 114.76},
+		{"Competência 2026", newTaxConfig2026(), 368.59, 0.00},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			payroll := NewPayroll(decimal.NewFromFloat(4000.00), 0, tc.config)
+
+			inss := payroll.Discounts[0].Value()
+			if !inss.Equal(decimal.NewFromFloat(tc.expectedINSS)) {
+				t.Errorf("%s: INSS esperado %.2f, obtido %s", tc.name, tc.expectedINSS, inss)
+			}
+			irrf := payroll.Discounts[1].Value()
+			if !irrf.Equal(decimal.NewFromFloat(tc.expectedIRRF)) {
+				t.Errorf("%s: IRRF esperado %.2f, obtido %s", tc.name, tc.expectedIRRF, irrf)
+			}
+		})
+	}
+}
+
+// testEarning é um provento com incidência configurável usado nos testes
+type testEarning struct {
+	value     decimal.Decimal
+	incidence Incidence
+}
+
+func (e testEarning) Value() decimal.Decimal { return e.value }
+func (e testEarning) Name() string           { return "Provento de teste" }
+func (e testEarning) Incidence() Incidence   { return e.incidence }
+
+// TestPayroll_BasesFromEarningIncidence testa que o bruto soma todos os
+// proventos, mas o INSS e o IRRF incidem só sobre os proventos sujeitos a eles
+func TestPayroll_BasesFromEarningIncidence(t *testing.T) {
+	earnings := []Earning{
+		NewBaseSalary(decimal.NewFromFloat(4000.00)),
+		testEarning{value: decimal.NewFromFloat(500.00), incidence: Incidence{FGTS: true}},
+		testEarning{value: decimal.NewFromFloat(300.00), incidence: Incidence{}},
+	}
+
+	payroll := NewPayrollFromEarnings(earnings, 0, newTaxConfig2025())
+
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"Bruto", payroll.GrossPay, 4800.00},
+		{"Base do INSS", payroll.INSSBase(), 4000.00},
+		{"Base do IRRF", payroll.IRRFBase(), 4000.00},
+		{"Base do FGTS", payroll.FGTSBase(), 4500.00},
+		{"INSS", payroll.Discounts[0].Value(), 373.41},
+		{"IRRF", payroll.Discounts[1].Value(), 114.76},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+var (
+	// proLaboreINSSRate é a contribuição do sócio como contribuinte
+	// individual, retida pela empresa até o teto (Lei nº 10.666/2003)
+	proLaboreINSSRate = decimal.NewFromFloat(0.11)
+	// employerContributionRate é a contribuição patronal sobre a remuneração
+	// do contribuinte individual, sem teto (Lei nº 8.212/1991, art. 22, III)
+	employerContributionRate = decimal.NewFromFloat(0.2)
+)
+
+// ProLaboreEarning é a remuneração do sócio pelo trabalho na empresa. Tem
+// INSS e IRRF, mas não tem FGTS.
+type ProLaboreEarning struct {
+	amount decimal.Decimal
+}
+
+func NewProLaboreEarning(amount decimal.Decimal) *ProLaboreEarning {
+	return &ProLaboreEarning{
+		amount: amount,
+	}
+}
+
+func (p ProLaboreEarning) Value() decimal.Decimal {
+	return p.amount.RoundBank(2)
+}
+
+func (p ProLaboreEarning) Name() string {
+	return "Pró-labore"
+}
+
+func (p ProLaboreEarning) Incidence() Incidence {
+	return Incidence{INSS: true, IRRF: true}
+}
+
+// ProLaboreINSSDiscount é a contribuição de 11% do contribuinte individual,
+// limitada ao teto do INSS, no lugar da tabela progressiva dos empregados
+type ProLaboreINSSDiscount struct {
+	GrossPay decimal.Decimal
+	Config   *TaxConfig
+}
+
+func NewProLaboreINSSDiscount(grossPay decimal.Decimal, config *TaxConfig) *ProLaboreINSSDiscount {
+	return &ProLaboreINSSDiscount{
+		GrossPay: grossPay,
+		Config:   config,
+	}
+}
+
+func (i ProLaboreINSSDiscount) Value() decimal.Decimal {
+	return decimal.Min(i.GrossPay, i.Config.INSSCeiling()).Mul(proLaboreINSSRate).Truncate(2)
+}
+
+func (i ProLaboreINSSDiscount) Name() string {
+	return "INSS"
+}
+
+func proLaboreINSS(base decimal.Decimal, config *TaxConfig) Discount {
+	return NewProLaboreINSSDiscount(base, config)
+}
+
+// ProLabore é a folha do sócio: o INSS de contribuinte individual, o IRRF
+// pela tabela mensal, com as mesmas deduções e ajustes dos empregados, e a
+// contribuição patronal de 20% paga pela empresa
+type ProLabore struct {
+	Payroll *Payroll
+}
+
+func NewProLabore(amount decimal.Decimal, numberOfDependents int64, config *TaxConfig, additionalDiscounts ...Discount) *ProLabore {
+	return &ProLabore{
+		Payroll: newPayroll([]Earning{NewProLaboreEarning(amount)}, numberOfDependents, config, proLaboreINSS, additionalDiscounts...),
+	}
+}
+
+// EmployerContribution é a contribuição patronal sobre o pró-labore
+func (p *ProLabore) EmployerContribution() decimal.Decimal {
+	return p.Payroll.INSSBase().Mul(employerContributionRate).RoundBank(2)
+}
+
+// TotalCost é o custo da empresa com o pró-labore
+func (p *ProLabore) TotalCost() decimal.Decimal {
+	return p.Payroll.GrossPay.Add(p.EmployerContribution())
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestProLabore testa o INSS de 11% até o teto, o IRRF pela tabela mensal e a
+// contribuição patronal de 20% sem teto
+func TestProLabore(t *testing.T) {
+	config := newTaxConfig2026()
+
+	testCases := []struct {
+		name         string
+		amount       float64
+		expectedINSS float64
+		expectedCost float64
+	}{
+		{"Pró-labore de R$ 5.000,00", 5000.00, 550.00, 6000.00},
+		{"Pró-labore acima do teto", 10000.00, 932.31, 12000.00},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount := decimal.NewFromFloat(tc.amount)
+			proLabore := NewProLabore(amount, 0, config)
+			payroll := proLabore.Payroll
+
+			inss := payroll.Discounts[0].Value()
+			if !inss.Equal(decimal.NewFromFloat(tc.expectedINSS)) {
+				t.Errorf("%s: INSS esperado %.2f, obtido %s", tc.name, tc.expectedINSS, inss)
+			}
+
+			expectedIRRF := NewIRRFDiscount(amount, 0, inss, config).Value()
+			if irrf := payroll.Discounts[1].Value(); !irrf.Equal(expectedIRRF) {
+				t.Errorf("%s: IRRF esperado %s, obtido %s", tc.name, expectedIRRF, irrf)
+			}
+
+			if !payroll.FGTSBase().IsZero() {
+				t.Errorf("%s: pró-labore não tem FGTS, base obtida %s", tc.name, payroll.FGTSBase())
+			}
+			if !proLabore.TotalCost().Equal(decimal.NewFromFloat(tc.expectedCost)) {
+				t.Errorf("%s: custo esperado %.2f, obtido %s", tc.name, tc.expectedCost, proLabore.TotalCost())
+			}
+		})
+	}
+}
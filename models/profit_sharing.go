@@ -0,0 +1,53 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// ProfitSharing é um pagamento de participação nos lucros ou resultados
+// (PLR). Não tem incidência de INSS nem de FGTS e o IRRF é de tributação
+// exclusiva, pela tabela anual de PLR e não pela tabela mensal. Os
+// pagamentos do mesmo ano civil são somados: o imposto é calculado sobre o
+// total e o já retido nos pagamentos anteriores é descontado.
+type ProfitSharing struct {
+	Amount decimal.Decimal
+	// PreviousAmount é a PLR já paga no ano civil e PreviousWithheld o IRRF
+	// retido sobre ela
+	PreviousAmount   decimal.Decimal
+	PreviousWithheld decimal.Decimal
+	Config           *TaxConfig
+}
+
+func NewProfitSharing(amount, previousAmount, previousWithheld decimal.Decimal, config *TaxConfig) *ProfitSharing {
+	return &ProfitSharing{
+		Amount:           amount,
+		PreviousAmount:   previousAmount,
+		PreviousWithheld: previousWithheld,
+		Config:           config,
+	}
+}
+
+// AccumulatedAmount é a PLR do ano civil somada ao pagamento atual
+func (p *ProfitSharing) AccumulatedAmount() decimal.Decimal {
+	return p.PreviousAmount.Add(p.Amount)
+}
+
+// IRRF é o imposto sobre o total do ano menos o já retido, nunca negativo
+func (p *ProfitSharing) IRRF() decimal.Decimal {
+	tax := ProfitSharingTax(p.AccumulatedAmount(), p.Config).Sub(p.PreviousWithheld)
+	return decimal.Max(tax, decimal.Zero).RoundBank(2)
+}
+
+func (p *ProfitSharing) NetPay() decimal.Decimal {
+	return p.Amount.Sub(p.IRRF())
+}
+
+// ProfitSharingTax calcula o IRRF de um valor de PLR pela tabela anual, sem
+// deduções. Também estima o imposto retido nos pagamentos anteriores quando
+// ele não é informado.
+func ProfitSharingTax(amount decimal.Decimal, config *TaxConfig) decimal.Decimal {
+	plrRange := findIRRFRange(config.PLRRanges, amount)
+	if plrRange == nil {
+		return decimal.Zero
+	}
+	tax := amount.Mul(plrRange.Aliquot).Sub(plrRange.Deduction)
+	return decimal.Max(tax, decimal.Zero).RoundBank(2)
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func plrRanges2025() []IRRFRange {
+	return []IRRFRange{
+		*NewIRRFRange(decimal.RequireFromString("0.00"), decimal.RequireFromString("7640.80"), decimal.Zero, decimal.Zero),
+		*NewIRRFRange(decimal.RequireFromString("7640.81"), decimal.RequireFromString("9922.28"), decimal.RequireFromString("0.075"), decimal.RequireFromString("573.06")),
+		*NewIRRFRange(decimal.RequireFromString("9922.29"), decimal.RequireFromString("13167.00"), decimal.RequireFromString("0.15"), decimal.RequireFromString("1317.23")),
+		*NewIRRFRange(decimal.RequireFromString("13167.01"), decimal.RequireFromString("16380.38"), decimal.RequireFromString("0.225"), decimal.RequireFromString("2304.76")),
+		*NewIRRFRange(decimal.RequireFromString("16380.39"), decimal.RequireFromString("999999999.99"), decimal.RequireFromString("0.275"), decimal.RequireFromString("3123.78")),
+	}
+}
+
+// TestProfitSharingTax testa a tabela anual da PLR, sem deduções
+func TestProfitSharingTax(t *testing.T) {
+	config := newTaxConfig2026()
+	config.PLRRanges = plrRanges2025()
+
+	testCases := []struct {
+		name     string
+		amount   float64
+		expected float64
+	}{
+		{"Isenta até R$ 7.640,80", 7640.80, 0},
+		{"Faixa de 7,5%", 9000.00, 101.94},
+		{"Faixa de 15%", 12000.00, 482.77},
+		{"Faixa de 27,5%", 20000.00, 2376.22},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tax := ProfitSharingTax(decimal.NewFromFloat(tc.amount), config)
+			if !tax.Equal(decimal.NewFromFloat(tc.expected)) {
+				t.Errorf("%s: IRRF esperado %.2f, obtido %s", tc.name, tc.expected, tax)
+			}
+		})
+	}
+}
+
+// TestProfitSharing_Accumulated testa o segundo pagamento do ano: o imposto
+// é calculado sobre o total de R$ 12.000,00 e o retido no primeiro é descontado
+func TestProfitSharing_Accumulated(t *testing.T) {
+	config := newTaxConfig2026()
+	config.PLRRanges = plrRanges2025()
+
+	plr := NewProfitSharing(decimal.NewFromFloat(3000.00), decimal.NewFromFloat(9000.00), decimal.NewFromFloat(101.94), config)
+
+	if !plr.IRRF().Equal(decimal.NewFromFloat(380.83)) {
+		t.Errorf("IRRF esperado 380.83, obtido %s", plr.IRRF())
+	}
+	if !plr.NetPay().Equal(decimal.NewFromFloat(2619.17)) {
+		t.Errorf("Líquido esperado 2619.17, obtido %s", plr.NetPay())
+	}
+
+	// Sozinho, o mesmo pagamento seria isento
+	alone := NewProfitSharing(decimal.NewFromFloat(3000.00), decimal.Zero, decimal.Zero, config)
+	if !alone.IRRF().IsZero() {
+		t.Errorf("PLR de R$ 3.000,00 sem pagamentos anteriores deve ser isenta, obtido %s", alone.IRRF())
+	}
+}
@@ -0,0 +1,130 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// commercialMonthDays é a duração do mês comercial
+const commercialMonthDays = 30
+
+// ProrationConvention define como os dias do mês são contados no salário
+// proporcional
+type ProrationConvention string
+
+const (
+	// ProrationCommercial considera todo mês com 30 dias
+	ProrationCommercial ProrationConvention = "commercial"
+	// ProrationCalendar considera os dias do calendário do mês
+	ProrationCalendar ProrationConvention = "calendar"
+)
+
+func ParseProrationConvention(value string) (ProrationConvention, error) {
+	switch convention := ProrationConvention(value); convention {
+	case ProrationCommercial, ProrationCalendar:
+		return convention, nil
+	default:
+		return "", fmt.Errorf("invalid proration convention %q: expected commercial or calendar", value)
+	}
+}
+
+// Proration é a fração do mês trabalhada: DaysWorked de MonthDays dias
+type Proration struct {
+	DaysWorked int `json:"days_worked"`
+	MonthDays  int `json:"month_days"`
+}
+
+// NewProration conta os dias trabalhados na competência entre a admissão e o
+// desligamento, ambos inclusivos e opcionais. Datas fora da competência são
+// ignoradas. Na convenção comercial, quem trabalha até o fim do mês recebe até
+// o dia 30, inclusive em fevereiro e nos meses de 31 dias.
+func NewProration(competence Competence, admission, termination *time.Time, convention ProrationConvention) (Proration, error) {
+	if admission != nil && termination != nil && termination.Before(*admission) {
+		return Proration{}, errors.New("termination is before admission")
+	}
+
+	lastDay := competence.Days()
+	start, end := 1, lastDay
+	if admission != nil {
+		switch admitted := CompetenceOf(*admission); {
+		case admitted.After(competence):
+			return Proration{}, fmt.Errorf("admission %s is after competence %s", admission.Format(time.DateOnly), competence)
+		case admitted == competence:
+			start = admission.Day()
+		}
+	}
+	if termination != nil {
+		switch terminated := CompetenceOf(*termination); {
+		case terminated.Before(competence):
+			return Proration{}, fmt.Errorf("termination %s is before competence %s", termination.Format(time.DateOnly), competence)
+		case terminated == competence:
+			end = termination.Day()
+		}
+	}
+
+	if convention == ProrationCalendar {
+		return Proration{DaysWorked: end - start + 1, MonthDays: lastDay}, nil
+	}
+
+	days := end - start + 1
+	if end == lastDay {
+		days = commercialMonthDays - start + 1
+	}
+	days = min(max(days, 1), commercialMonthDays)
+	return Proration{DaysWorked: days, MonthDays: commercialMonthDays}, nil
+}
+
+// IsFull indica se o mês inteiro foi trabalhado
+func (p Proration) IsFull() bool {
+	return p.DaysWorked >= p.MonthDays
+}
+
+// Apply calcula a parte proporcional de um valor mensal
+func (p Proration) Apply(amount decimal.Decimal) decimal.Decimal {
+	if p.MonthDays <= 0 {
+		return decimal.Zero
+	}
+	return amount.Mul(decimal.NewFromInt(int64(p.DaysWorked))).Div(decimal.NewFromInt(int64(p.MonthDays)))
+}
+
+// ProratedEarning é um provento proporcional aos dias trabalhados que informa
+// o valor do mês inteiro e a proporção aplicada, para que o valor possa ser
+// conferido
+type ProratedEarning interface {
+	Earning
+	Prorated() (monthlyValue decimal.Decimal, proration Proration)
+}
+
+// ProportionalSalary é o salário mensal proporcional aos dias trabalhados,
+// usado nos meses de admissão e de desligamento. Guarda o salário mensal para
+// que o valor possa ser conferido.
+type ProportionalSalary struct {
+	MonthlySalary decimal.Decimal
+	Proration     Proration
+}
+
+func NewProportionalSalary(monthlySalary decimal.Decimal, proration Proration) *ProportionalSalary {
+	return &ProportionalSalary{
+		MonthlySalary: monthlySalary,
+		Proration:     proration,
+	}
+}
+
+func (ps ProportionalSalary) Value() decimal.Decimal {
+	return ps.Proration.Apply(ps.MonthlySalary).RoundBank(2)
+}
+
+func (ps ProportionalSalary) Prorated() (decimal.Decimal, Proration) {
+	return ps.MonthlySalary, ps.Proration
+}
+
+func (ps ProportionalSalary) Name() string {
+	return fmt.Sprintf("Salário proporcional (%d/%d dias)", ps.Proration.DaysWorked, ps.Proration.MonthDays)
+}
+
+func (ps ProportionalSalary) Incidence() Incidence {
+	return FullIncidence
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func date(year int, month time.Month, day int) *time.Time {
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &d
+}
+
+// TestNewProration testa a contagem de dias nas convenções comercial e de calendário
+func TestNewProration(t *testing.T) {
+	testCases := []struct {
+		name        string
+		competence  Competence
+		admission   *time.Time
+		termination *time.Time
+		convention  ProrationConvention
+		expected    Proration
+	}{
+		{"Mês inteiro", NewCompetence(2026, time.March), nil, nil, ProrationCommercial, Proration{30, 30}},
+		{"Admissão no dia 16 de um mês de 31 dias", NewCompetence(2026, time.March), date(2026, time.March, 16), nil, ProrationCommercial, Proration{15, 30}},
+		{"Admissão no dia 15 de fevereiro", NewCompetence(2026, time.February), date(2026, time.February, 15), nil, ProrationCommercial, Proration{16, 30}},
+		{"Admissão no dia 31", NewCompetence(2026, time.March), date(2026, time.March, 31), nil, ProrationCommercial, Proration{1, 30}},
+		{"Desligamento no dia 10", NewCompetence(2026, time.March), nil, date(2026, time.March, 10), ProrationCommercial, Proration{10, 30}},
+		{"Desligamento no último dia de fevereiro", NewCompetence(2026, time.February), nil, date(2026, time.February, 28), ProrationCommercial, Proration{30, 30}},
+		{"Admissão e desligamento no mesmo mês", NewCompetence(2026, time.March), date(2026, time.March, 5), date(2026, time.March, 20), ProrationCommercial, Proration{16, 30}},
+		{"Admissão em mês anterior", NewCompetence(2026, time.March), date(2025, time.July, 20), date(2026, time.March, 10), ProrationCommercial, Proration{10, 30}},
+		{"Calendário em fevereiro", NewCompetence(2026, time.February), date(2026, time.February, 15), nil, ProrationCalendar, Proration{14, 28}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proration, err := NewProration(tc.competence, tc.admission, tc.termination, tc.convention)
+			if err != nil {
+				t.Fatalf("Erro inesperado: %v", err)
+			}
+			if proration != tc.expected {
+				t.Errorf("%s: esperado %+v, obtido %+v", tc.name, tc.expected, proration)
+			}
+		})
+	}
+}
+
+// TestNewProration_Invalid testa períodos que não se sobrepõem à competência
+func TestNewProration_Invalid(t *testing.T) {
+	competence := NewCompetence(2026, time.March)
+
+	if _, err := NewProration(competence, date(2026, time.April, 1), nil, ProrationCommercial); err == nil {
+		t.Error("Admissão depois da competência deve retornar erro")
+	}
+	if _, err := NewProration(competence, nil, date(2026, time.February, 28), ProrationCommercial); err == nil {
+		t.Error("Desligamento antes da competência deve retornar erro")
+	}
+	if _, err := NewProration(competence, date(2026, time.March, 20), date(2026, time.March, 10), ProrationCommercial); err == nil {
+		t.Error("Desligamento antes da admissão deve retornar erro")
+	}
+}
+
+// TestPayroll_ProportionalSalary testa o INSS e o IRRF sobre o salário proporcional
+func TestPayroll_ProportionalSalary(t *testing.T) {
+	salary := NewProportionalSalary(decimal.NewFromFloat(6000.00), Proration{DaysWorked: 20, MonthDays: 30})
+
+	if salary.Name() != "Salário proporcional (20/30 dias)" {
+		t.Errorf("Nome inesperado: %s", salary.Name())
+	}
+	if monthly, proration := salary.Prorated(); !monthly.Equal(decimal.NewFromFloat(6000.00)) || proration.DaysWorked != 20 || proration.MonthDays != 30 {
+		t.Errorf("Esperado salário mensal de 6000 e 20/30 dias, obtido %s e %d/%d dias", monthly, proration.DaysWorked, proration.MonthDays)
+	}
+
+	payroll := NewPayrollFromEarnings([]Earning{salary}, 0, newTaxConfig2025())
+
+	// 6.000,00 × 20/30
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"Bruto", payroll.GrossPay, 4000.00},
+		{"Base do INSS", payroll.INSSBase(), 4000.00},
+		// 113,85 + 114,83 + 1.206,12 × 12%
+		{"INSS", payroll.Discounts[0].Value(), 373.41},
+		// (4.000,00 - 607,20) × 15% - 394,16
+		{"IRRF", payroll.Discounts[1].Value(), 114.76},
+		{"Líquido", payroll.NetPay(), 3511.83},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
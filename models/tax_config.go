@@ -0,0 +1,92 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrTaxConfigNotFound = errors.New("no tax config in force for competence")
+
+// TaxConfig reúne as tabelas e parâmetros de INSS e IRRF vigentes entre
+// ValidFrom e ValidUntil (inclusive). ValidFrom zerado indica vigência desde
+// sempre e ValidUntil nulo indica vigência sem data de término.
+type TaxConfig struct {
+	// Version é a versão das regras de onde a tabela foi obtida
+	Version    string      `json:"-"`
+	ValidFrom  Competence  `json:"valid_from"`
+	ValidUntil *Competence `json:"valid_until,omitempty"`
+	INSSRanges []INSSRange `json:"inss_ranges"`
+	// INSSFlatRate indica a regra anterior a março de 2020, em que a alíquota
+	// da faixa incide sobre todo o salário de contribuição
+	INSSFlatRate                  bool            `json:"inss_flat_rate,omitempty"`
+	IRRFRanges                    []IRRFRange     `json:"irrf_ranges"`
+	DependentDeduction            decimal.Decimal `json:"dependent_deduction"`
+	SimplifiedDeductionPercentage decimal.Decimal `json:"simplified_deduction_percentage"`
+	// IRRFReduction é a forma abreviada de configurar o ajuste da Lei nº
+	// 15.270/2025, aplicado antes dos demais ajustes
+	IRRFReduction   *IRRFReduction       `json:"irrf_reduction,omitempty"`
+	IRRFAdjustments []IRRFAdjustmentSpec `json:"irrf_adjustments,omitempty"`
+	// MinimumWage é o salário mínimo nacional, referência do adicional de
+	// insalubridade. Zero indica que não foi configurado.
+	MinimumWage decimal.Decimal `json:"minimum_wage,omitempty"`
+	// FamilyAllowance são os parâmetros do salário-família. Nulo indica que
+	// o benefício não é calculado.
+	FamilyAllowance *FamilyAllowanceConfig `json:"family_allowance,omitempty"`
+	// AllowanceIncidences substitui, por tipo, a incidência padrão das verbas
+	// indenizatórias e dos prêmios
+	AllowanceIncidences map[AllowanceType]Incidence `json:"allowance_incidences,omitempty"`
+	// PLRRanges é a tabela progressiva anual da participação nos lucros ou
+	// resultados, de tributação exclusiva (Lei nº 10.101/2000). Vazia indica
+	// que a PLR não é calculada.
+	PLRRanges []IRRFRange `json:"plr_ranges,omitempty"`
+}
+
+// FamilyAllowanceConfig é o valor da cota do salário-família por filho e o
+// limite de remuneração para ter direito ao benefício
+type FamilyAllowanceConfig struct {
+	Quota         decimal.Decimal `json:"quota"`
+	IncomeCeiling decimal.Decimal `json:"income_ceiling"`
+}
+
+// AppliesTo indica se a tabela está vigente na competência informada
+func (t *TaxConfig) AppliesTo(competence Competence) bool {
+	if competence.Before(t.ValidFrom) {
+		return false
+	}
+	return t.ValidUntil == nil || !competence.After(*t.ValidUntil)
+}
+
+// INSSCeiling retorna o teto do INSS: o fim da última faixa com alíquota
+// positiva. Faixas acima do teto com alíquota zero são aceitas por
+// compatibilidade com tabelas antigas.
+func (t *TaxConfig) INSSCeiling() decimal.Decimal {
+	for i := len(t.INSSRanges) - 1; i >= 0; i-- {
+		if t.INSSRanges[i].Aliquot.IsPositive() {
+			return t.INSSRanges[i].EndValue
+		}
+	}
+	return decimal.Zero
+}
+
+// IRRFAdjustmentPipeline retorna os ajustes aplicados, em ordem, ao imposto
+// calculado pela tabela progressiva
+func (t *TaxConfig) IRRFAdjustmentPipeline() []IRRFAdjustment {
+	adjustments := make([]IRRFAdjustment, 0, len(t.IRRFAdjustments)+1)
+	if t.IRRFReduction != nil {
+		adjustments = append(adjustments, t.IRRFReduction)
+	}
+	for _, spec := range t.IRRFAdjustments {
+		adjustments = append(adjustments, spec.Adjustment)
+	}
+	return adjustments
+}
+
+// AllowanceIncidence retorna a incidência de um tipo de verba: a configurada
+// na tabela ou, na ausência dela, a padrão da legislação
+func (t *TaxConfig) AllowanceIncidence(allowanceType AllowanceType) Incidence {
+	if incidence, ok := t.AllowanceIncidences[allowanceType]; ok {
+		return incidence
+	}
+	return defaultAllowanceIncidences[allowanceType]
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Tabelas oficiais usadas pelos testes, montadas sem variáveis de ambiente
+// para que cada teste receba seu próprio TaxConfig
+
+func irrfRanges2026() []IRRFRange {
+	return []IRRFRange{
+		*NewIRRFRange(decimal.RequireFromString("0.00"), decimal.RequireFromString("2428.80"), decimal.Zero, decimal.Zero),
+		*NewIRRFRange(decimal.RequireFromString("2428.81"), decimal.RequireFromString("2826.65"), decimal.RequireFromString("0.075"), decimal.RequireFromString("182.16")),
+		*NewIRRFRange(decimal.RequireFromString("2826.66"), decimal.RequireFromString("3751.05"), decimal.RequireFromString("0.15"), decimal.RequireFromString("394.16")),
+		*NewIRRFRange(decimal.RequireFromString("3751.06"), decimal.RequireFromString("4664.68"), decimal.RequireFromString("0.225"), decimal.RequireFromString("675.49")),
+		*NewIRRFRange(decimal.RequireFromString("4664.69"), decimal.RequireFromString("999999999.99"), decimal.RequireFromString("0.275"), decimal.RequireFromString("908.73")),
+	}
+}
+
+func inssRanges2026() []INSSRange {
+	return []INSSRange{
+		*NewINSSRange(1, decimal.RequireFromString("0.075"), decimal.RequireFromString("0.00"), decimal.RequireFromString("1621.00")),
+		*NewINSSRange(2, decimal.RequireFromString("0.09"), decimal.RequireFromString("1621.01"), decimal.RequireFromString("2902.84")),
+		*NewINSSRange(3, decimal.RequireFromString("0.12"), decimal.RequireFromString("2902.85"), decimal.RequireFromString("4354.27")),
+		*NewINSSRange(4, decimal.RequireFromString("0.14"), decimal.RequireFromString("4354.28"), decimal.RequireFromString("8475.55")),
+	}
+}
+
+func newTaxConfig2026() *TaxConfig {
+	return &TaxConfig{
+		Version:                       "test",
+		ValidFrom:                     NewCompetence(2026, time.January),
+		INSSRanges:                    inssRanges2026(),
+		IRRFRanges:                    irrfRanges2026(),
+		DependentDeduction:            decimal.RequireFromString("189.59"),
+		SimplifiedDeductionPercentage: decimal.RequireFromString("0.25"),
+		MinimumWage:                   decimal.RequireFromString("1621.00"),
+		FamilyAllowance: &FamilyAllowanceConfig{
+			Quota:         decimal.RequireFromString("67.54"),
+			IncomeCeiling: decimal.RequireFromString("1980.38"),
+		},
+		IRRFReduction: &IRRFReduction{
+			MaxAmount:  decimal.RequireFromString("312.89"),
+			Threshold:  decimal.RequireFromString("5000.00"),
+			UpperLimit: decimal.RequireFromString("7350.00"),
+			Constant:   decimal.RequireFromString("978.62"),
+			Multiplier: decimal.RequireFromString("0.133145"),
+		},
+	}
+}
+
+// newTaxConfig2025 retorna a tabela vigente de maio a dezembro de 2025, com
+// as mesmas faixas do IRRF de 2026 mas sem a redução da Lei nº 15.270/2025
+func newTaxConfig2025() *TaxConfig {
+	validUntil := NewCompetence(2025, time.December)
+	return &TaxConfig{
+		Version:    "test",
+		ValidFrom:  NewCompetence(2025, time.May),
+		ValidUntil: &validUntil,
+		INSSRanges: []INSSRange{
+			*NewINSSRange(1, decimal.RequireFromString("0.075"), decimal.RequireFromString("0.00"), decimal.RequireFromString("1518.00")),
+			*NewINSSRange(2, decimal.RequireFromString("0.09"), decimal.RequireFromString("1518.01"), decimal.RequireFromString("2793.88")),
+			*NewINSSRange(3, decimal.RequireFromString("0.12"), decimal.RequireFromString("2793.89"), decimal.RequireFromString("4190.83")),
+			*NewINSSRange(4, decimal.RequireFromString("0.14"), decimal.RequireFromString("4190.84"), decimal.RequireFromString("8157.41")),
+		},
+		IRRFRanges:                    irrfRanges2026(),
+		DependentDeduction:            decimal.RequireFromString("189.59"),
+		SimplifiedDeductionPercentage: decimal.RequireFromString("0.25"),
+		MinimumWage:                   decimal.RequireFromString("1518.00"),
+		FamilyAllowance: &FamilyAllowanceConfig{
+			Quota:         decimal.RequireFromString("65.00"),
+			IncomeCeiling: decimal.RequireFromString("1906.04"),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestTaxRulesConfigFor_SelectsTableInForce testa a escolha da tabela pela competência
+func TestTaxRulesConfigFor_SelectsTableInForce(t *testing.T) {
+	until2025 := NewCompetence(2025, time.December)
+	rules := &TaxRules{Version: "test", Tables: []TaxConfig{
+		{ValidFrom: NewCompetence(2025, time.January), ValidUntil: &until2025, DependentDeduction: decimal.NewFromFloat(189.59)},
+		{ValidFrom: NewCompetence(2026, time.January), DependentDeduction: decimal.NewFromFloat(200.00)},
+	}}
+
+	testCases := []struct {
+		competence Competence
+		expected   float64
+	}{
+		{NewCompetence(2025, time.January), 189.59},
+		{NewCompetence(2025, time.December), 189.59},
+		{NewCompetence(2026, time.January), 200.00},
+		{NewCompetence(2030, time.June), 200.00},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.competence.String(), func(t *testing.T) {
+			config, err := rules.ConfigFor(tc.competence)
+			if err != nil {
+				t.Fatalf("Erro inesperado: %v", err)
+			}
+			if !config.DependentDeduction.Equal(decimal.NewFromFloat(tc.expected)) {
+				t.Errorf("Dedução por dependente esperada %.2f, obtida %s", tc.expected, config.DependentDeduction)
+			}
+		})
+	}
+
+	if _, err := rules.ConfigFor(NewCompetence(2024, time.December)); !errors.Is(err, ErrTaxConfigNotFound) {
+		t.Errorf("Competência sem tabela vigente deve retornar ErrTaxConfigNotFound. Obtido: %v", err)
+	}
+}
+
+// TestParseCompetence testa a leitura de competências no formato AAAA-MM
+func TestParseCompetence(t *testing.T) {
+	competence, err := ParseCompetence("2025-12")
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if competence != NewCompetence(2025, time.December) {
+		t.Errorf("Competência esperada 2025-12, obtida %s", competence)
+	}
+
+	if _, err := ParseCompetence("12/2025"); err == nil {
+		t.Error("Competência em formato inválido deve retornar erro")
+	}
+}
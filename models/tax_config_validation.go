@@ -0,0 +1,188 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// rangeStep é a distância entre o fim de uma faixa e o início da seguinte
+	rangeStep = decimal.NewFromFloat(0.01)
+	// deductionTolerance absorve o arredondamento das parcelas a deduzir publicadas
+	deductionTolerance = decimal.NewFromFloat(0.01)
+	one                = decimal.NewFromInt(1)
+)
+
+// Validate confere se todas as tabelas das regras são consistentes
+func (r *TaxRules) Validate() error {
+	if len(r.Tables) == 0 {
+		return errors.New("tax rules have no tables")
+	}
+
+	var errs []error
+	for i := range r.Tables {
+		if err := r.Tables[i].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("table %d (valid from %s): %w", i, r.Tables[i].ValidFrom, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate confere a vigência, as faixas de INSS e IRRF e os parâmetros da tabela
+func (t *TaxConfig) Validate() error {
+	var errs []error
+
+	if t.ValidUntil != nil && t.ValidUntil.Before(t.ValidFrom) {
+		errs = append(errs, fmt.Errorf("valid_until %s is before valid_from %s", t.ValidUntil, t.ValidFrom))
+	}
+	if err := ValidateINSSRanges(t.INSSRanges); err != nil {
+		errs = append(errs, fmt.Errorf("inss_ranges: %w", err))
+	}
+	if err := ValidateIRRFRanges(t.IRRFRanges); err != nil {
+		errs = append(errs, fmt.Errorf("irrf_ranges: %w", err))
+	}
+	if t.DependentDeduction.IsNegative() {
+		errs = append(errs, errors.New("dependent_deduction must not be negative"))
+	}
+	if !isRate(t.SimplifiedDeductionPercentage) {
+		errs = append(errs, errors.New("simplified_deduction_percentage must be between 0 and 1"))
+	}
+	if t.MinimumWage.IsNegative() {
+		errs = append(errs, errors.New("minimum_wage must not be negative"))
+	}
+	if t.FamilyAllowance != nil {
+		if err := t.FamilyAllowance.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("family_allowance: %w", err))
+		}
+	}
+	for allowanceType := range t.AllowanceIncidences {
+		if _, err := ParseAllowanceType(string(allowanceType)); err != nil {
+			errs = append(errs, fmt.Errorf("allowance_incidences: %w", err))
+		}
+	}
+	if len(t.PLRRanges) > 0 {
+		if err := ValidateIRRFRanges(t.PLRRanges); err != nil {
+			errs = append(errs, fmt.Errorf("plr_ranges: %w", err))
+		}
+	}
+	if t.IRRFReduction != nil {
+		if err := t.IRRFReduction.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("irrf_reduction: %w", err))
+		}
+	}
+	for i, spec := range t.IRRFAdjustments {
+		if spec.Adjustment == nil {
+			errs = append(errs, fmt.Errorf("irrf_adjustments: adjustment %d has no type", i+1))
+		}
+		// A forma abreviada já entra no início da sequência de ajustes
+		if _, isReduction := spec.Adjustment.(*IRRFReduction); isReduction && t.IRRFReduction != nil {
+			errs = append(errs, fmt.Errorf("irrf_adjustments: adjustment %d repeats irrf_reduction, the reduction would apply twice", i+1))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *IRRFReduction) Validate() error {
+	var errs []error
+	if r.MaxAmount.IsNegative() || r.Constant.IsNegative() || r.Multiplier.IsNegative() {
+		errs = append(errs, errors.New("amounts must not be negative"))
+	}
+	if r.Threshold.GreaterThan(r.UpperLimit) {
+		errs = append(errs, fmt.Errorf("threshold %s is above upper_limit %s", r.Threshold, r.UpperLimit))
+	}
+	return errors.Join(errs...)
+}
+
+func (f *FamilyAllowanceConfig) Validate() error {
+	if f.Quota.IsNegative() || f.IncomeCeiling.IsNegative() {
+		return errors.New("amounts must not be negative")
+	}
+	return nil
+}
+
+// ValidateINSSRanges confere se as faixas estão ordenadas, com índices
+// sequenciais a partir de 1, contíguas e com alíquotas entre 0 e 1
+func ValidateINSSRanges(ranges []INSSRange) error {
+	if len(ranges) == 0 {
+		return errors.New("no ranges")
+	}
+
+	var errs []error
+	for i, inssRange := range ranges {
+		if inssRange.Index != i+1 {
+			errs = append(errs, fmt.Errorf("range %d has index %d, expected %d", i+1, inssRange.Index, i+1))
+		}
+		errs = append(errs, validateRange(i, inssRange.InitValue, inssRange.EndValue, inssRange.Aliquot)...)
+		if i > 0 {
+			errs = append(errs, validateContiguity(i, ranges[i-1].EndValue, inssRange.InitValue)...)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateIRRFRanges confere se as faixas começam em zero, estão ordenadas,
+// contíguas, com alíquotas crescentes entre 0 e 1 e com parcelas a deduzir
+// coerentes com a tabela progressiva
+func ValidateIRRFRanges(ranges []IRRFRange) error {
+	if len(ranges) == 0 {
+		return errors.New("no ranges")
+	}
+
+	var errs []error
+	if !ranges[0].StartingValue.IsZero() {
+		errs = append(errs, fmt.Errorf("first range starts at %s, expected 0", ranges[0].StartingValue))
+	}
+	for i, irrfRange := range ranges {
+		errs = append(errs, validateRange(i, irrfRange.StartingValue, irrfRange.EndingValue, irrfRange.Aliquot)...)
+		if i == 0 {
+			continue
+		}
+
+		previous := ranges[i-1]
+		errs = append(errs, validateContiguity(i, previous.EndingValue, irrfRange.StartingValue)...)
+		if irrfRange.Aliquot.LessThan(previous.Aliquot) {
+			errs = append(errs, fmt.Errorf("range %d aliquot %s is lower than the previous one", i+1, irrfRange.Aliquot))
+		}
+
+		// A parcela a deduzir de cada faixa é a da faixa anterior somada à
+		// diferença de alíquotas aplicada sobre o limite da faixa anterior
+		expected := previous.Deduction.Add(irrfRange.Aliquot.Sub(previous.Aliquot).Mul(previous.EndingValue))
+		if irrfRange.Deduction.Sub(expected).Abs().GreaterThan(deductionTolerance) {
+			errs = append(errs, fmt.Errorf("range %d deduction %s is inconsistent with the progressive table, expected %s",
+				i+1, irrfRange.Deduction, expected.RoundBank(2)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateRange(i int, start, end, aliquot decimal.Decimal) []error {
+	var errs []error
+	if start.IsNegative() {
+		errs = append(errs, fmt.Errorf("range %d starts at a negative value %s", i+1, start))
+	}
+	if end.LessThan(start) {
+		errs = append(errs, fmt.Errorf("range %d ends at %s before starting at %s", i+1, end, start))
+	}
+	if !isRate(aliquot) {
+		errs = append(errs, fmt.Errorf("range %d aliquot %s must be between 0 and 1", i+1, aliquot))
+	}
+	return errs
+}
+
+func validateContiguity(i int, previousEnd, start decimal.Decimal) []error {
+	expected := previousEnd.Add(rangeStep)
+	if start.GreaterThan(expected) {
+		return []error{fmt.Errorf("gap between range %d ending at %s and range %d starting at %s", i, previousEnd, i+1, start)}
+	}
+	if start.LessThan(expected) {
+		return []error{fmt.Errorf("range %d starting at %s overlaps range %d ending at %s", i+1, start, i, previousEnd)}
+	}
+	return nil
+}
+
+func isRate(value decimal.Decimal) bool {
+	return !value.IsNegative() && value.LessThanOrEqual(one)
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestValidateIRRFRanges_Official2026 testa que a tabela oficial de 2026 é aceita
+func TestValidateIRRFRanges_Official2026(t *testing.T) {
+	if err := ValidateIRRFRanges(irrfRanges2026()); err != nil {
+		t.Errorf("A tabela oficial do IRRF 2026 deve ser válida. Erro: %v", err)
+	}
+	if err := ValidateINSSRanges(inssRanges2026()); err != nil {
+		t.Errorf("A tabela oficial do INSS 2026 deve ser válida. Erro: %v", err)
+	}
+}
+
+// TestValidateIRRFRanges_Broken testa a rejeição de tabelas inconsistentes
+func TestValidateIRRFRanges_Broken(t *testing.T) {
+	testCases := []struct {
+		name  string
+		apply func(ranges []IRRFRange)
+	}{
+		{"Lacuna entre faixas", func(r []IRRFRange) { r[2].StartingValue = decimal.RequireFromString("2826.70") }},
+		{"Sobreposição de faixas", func(r []IRRFRange) { r[2].StartingValue = decimal.RequireFromString("2826.65") }},
+		{"Alíquota acima de 1", func(r []IRRFRange) { r[4].Aliquot = decimal.RequireFromString("27.5") }},
+		{"Parcela a deduzir inconsistente", func(r []IRRFRange) { r[3].Deduction = decimal.RequireFromString("636.13") }},
+		{"Faixas fora de ordem", func(r []IRRFRange) { r[1], r[2] = r[2], r[1] }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ranges := irrfRanges2026()
+			tc.apply(ranges)
+			if err := ValidateIRRFRanges(ranges); err == nil {
+				t.Errorf("%s: a tabela deveria ser rejeitada", tc.name)
+			}
+		})
+	}
+}
+
+// TestValidateINSSRanges_Broken testa a rejeição de faixas de INSS inconsistentes
+func TestValidateINSSRanges_Broken(t *testing.T) {
+	testCases := []struct {
+		name  string
+		apply func(ranges []INSSRange)
+	}{
+		{"Índice fora de sequência", func(r []INSSRange) { r[2].Index = 4 }},
+		{"Lacuna entre faixas", func(r []INSSRange) { r[1].InitValue = decimal.RequireFromString("1700.00") }},
+		{"Alíquota negativa", func(r []INSSRange) { r[0].Aliquot = decimal.RequireFromString("-0.075") }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ranges := inssRanges2026()
+			tc.apply(ranges)
+			if err := ValidateINSSRanges(ranges); err == nil {
+				t.Errorf("%s: a tabela deveria ser rejeitada", tc.name)
+			}
+		})
+	}
+
+	if err := ValidateINSSRanges(nil); err == nil {
+		t.Error("Uma tabela vazia deveria ser rejeitada")
+	}
+}
+
+// TestTaxConfigValidate_DuplicatedReduction testa que a forma abreviada e o
+// ajuste lei_15270_2025 não podem estar na mesma tabela
+func TestTaxConfigValidate_DuplicatedReduction(t *testing.T) {
+	config := newTaxConfig2026()
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	spec, err := NewIRRFAdjustmentSpec("lei_15270_2025", config.IRRFReduction)
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	config.IRRFAdjustments = []IRRFAdjustmentSpec{spec}
+	if err := config.Validate(); err == nil {
+		t.Error("irrf_reduction com o ajuste lei_15270_2025 deve ser rejeitado")
+	}
+
+	config.IRRFReduction = nil
+	if err := config.Validate(); err != nil {
+		t.Errorf("Apenas o ajuste lei_15270_2025 deve ser aceito. Erro: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+)
+
+// TaxRules é o documento versionado com todas as tabelas de impostos
+// conhecidas, cada uma com seu período de vigência
+type TaxRules struct {
+	Version string      `json:"version"`
+	Tables  []TaxConfig `json:"tables"`
+}
+
+// TaxTableProvider é a fonte de onde as regras de impostos são carregadas
+type TaxTableProvider interface {
+	Load() (*TaxRules, error)
+}
+
+// activeTaxRules guarda as regras usadas nos cálculos. A troca é atômica e
+// cada cálculo deve trabalhar sobre um único snapshot obtido com
+// CurrentTaxRules, para nunca misturar tabelas de versões diferentes.
+var activeTaxRules atomic.Pointer[TaxRules]
+
+// CurrentTaxRules retorna o snapshot das regras ativas
+func CurrentTaxRules() *TaxRules {
+	if rules := activeTaxRules.Load(); rules != nil {
+		return rules
+	}
+	return &TaxRules{}
+}
+
+// SetActiveTaxRules substitui atomicamente as regras ativas. A série da nova
+// versão é publicada antes de a anterior ser removida, para que a métrica
+// nunca fique sem versão ativa.
+func SetActiveTaxRules(rules *TaxRules) {
+	previous := activeTaxRules.Swap(rules)
+	taxRulesInfo.WithLabelValues(rules.Version).Set(1)
+	if previous != nil && previous.Version != rules.Version {
+		taxRulesInfo.DeleteLabelValues(previous.Version)
+	}
+}
+
+// NewTaxTableProviderFromEnv usa o arquivo indicado em TAX_RULES_FILE, as
+// variáveis de ambiente quando TAX_TABLES ou INSS_RANGES estão definidas e,
+// na ausência de ambos, as tabelas oficiais embutidas
+func NewTaxTableProviderFromEnv() TaxTableProvider {
+	if path := os.Getenv("TAX_RULES_FILE"); path != "" {
+		return NewFileTaxTableProvider(path)
+	}
+	if os.Getenv("TAX_TABLES") != "" || os.Getenv("INSS_RANGES") != "" {
+		return NewEnvTaxTableProvider()
+	}
+	return NewEmbeddedTaxTableProvider()
+}
+
+// LoadTaxRules carrega e valida as regras do provedor, recusando tabelas
+// inconsistentes em vez de calcular impostos com elas
+func LoadTaxRules(provider TaxTableProvider) (*TaxRules, error) {
+	rules, err := provider.Load()
+	if err != nil {
+		return nil, err
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid tax rules version %q: %w", rules.Version, err)
+	}
+	log.Printf("Loaded tax rules version %q with %d tables", rules.Version, len(rules.Tables))
+	return rules, nil
+}
+
+// SameTables indica se as duas versões têm as mesmas tabelas, comparando o
+// documento serializado para que valores decimais iguais escritos de formas
+// diferentes não sejam vistos como alteração
+func (r *TaxRules) SameTables(other *TaxRules) bool {
+	a, errA := json.Marshal(r.Tables)
+	b, errB := json.Marshal(other.Tables)
+	return errA == nil && errB == nil && bytes.Equal(a, b)
+}
+
+// ConfigFor retorna uma cópia da tabela vigente na competência informada,
+// identificada com a versão das regras. Quando mais de uma tabela se aplica,
+// prevalece a de início de vigência mais recente.
+func (r *TaxRules) ConfigFor(competence Competence) (*TaxConfig, error) {
+	var found *TaxConfig
+	for i := range r.Tables {
+		config := &r.Tables[i]
+		if !config.AppliesTo(competence) {
+			continue
+		}
+		if found == nil || config.ValidFrom.After(found.ValidFrom) {
+			found = config
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w %s", ErrTaxConfigNotFound, competence)
+	}
+
+	config := *found
+	config.Version = r.Version
+	return &config, nil
+}
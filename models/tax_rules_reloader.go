@@ -0,0 +1,179 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	taxRulesInfo = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "payroll_tax_rules_info",
+		Help: "Active tax rules version, the series with value 1 is the one in use.",
+	}, []string{"version"})
+	taxRulesReloads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "payroll_tax_rules_reloads_total",
+		Help: "Tax rules reload attempts by result.",
+	}, []string{"result"})
+)
+
+// TaxRulesReloader recarrega as regras do provedor e, se forem válidas,
+// substitui as regras ativas e as publica no store (quando informado). Uma
+// versão já publicada com outras tabelas é rejeitada, pois versões publicadas
+// não mudam. Se o store tiver uma versão ativada pela API, ela continua ativa
+// e as regras do provedor são apenas publicadas. Em caso de erro as regras
+// anteriores continuam em uso.
+type TaxRulesReloader struct {
+	provider TaxTableProvider
+	store    TaxRulesStore
+	mu       sync.Mutex
+}
+
+func NewTaxRulesReloader(provider TaxTableProvider, store TaxRulesStore) *TaxRulesReloader {
+	return &TaxRulesReloader{provider: provider, store: store}
+}
+
+func (r *TaxRulesReloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	loaded, err := LoadTaxRules(r.provider)
+	if err == nil {
+		err = r.checkPublished(loaded)
+	}
+	if err != nil {
+		taxRulesReloads.WithLabelValues("failure").Inc()
+		return err
+	}
+
+	activationMu.Lock()
+	defer activationMu.Unlock()
+
+	rules, err := r.activeRules(loaded)
+	if err != nil {
+		taxRulesReloads.WithLabelValues("failure").Inc()
+		return err
+	}
+
+	SetActiveTaxRules(rules)
+	taxRulesReloads.WithLabelValues("success").Inc()
+	r.publish(loaded)
+	return nil
+}
+
+// activeRules retorna a versão ativada pela API, quando houver uma diferente
+// da carregada, e caso contrário as regras carregadas do provedor
+func (r *TaxRulesReloader) activeRules(loaded *TaxRules) (*TaxRules, error) {
+	if r.store == nil {
+		return loaded, nil
+	}
+	version, err := r.store.Active()
+	if err != nil {
+		return nil, fmt.Errorf("reading the activated tax rules version: %w", err)
+	}
+	if version == "" || version == loaded.Version {
+		return loaded, nil
+	}
+
+	rules, err := r.store.Get(version)
+	if err != nil {
+		return nil, fmt.Errorf("loading the activated tax rules version %q: %w", version, err)
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid activated tax rules version %q: %w", version, err)
+	}
+	log.Printf("Keeping tax rules version %q activated through the API, version %q from the provider is only published", version, loaded.Version)
+	return rules, nil
+}
+
+// checkPublished recusa regras cuja versão já foi publicada com outras
+// tabelas, para que o store e as regras ativas nunca divirjam
+func (r *TaxRulesReloader) checkPublished(rules *TaxRules) error {
+	if r.store == nil {
+		return nil
+	}
+	published, err := r.store.Get(rules.Version)
+	if errors.Is(err, ErrTaxRulesVersionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("checking published tax rules version %q: %w", rules.Version, err)
+	}
+	if !published.SameTables(rules) {
+		return fmt.Errorf("%w: %q was published with different tables, bump the version", ErrTaxRulesVersionExists, rules.Version)
+	}
+	return nil
+}
+
+// publish guarda a versão no store. Uma versão idêntica já publicada é
+// mantida como está.
+func (r *TaxRulesReloader) publish(rules *TaxRules) {
+	if r.store == nil {
+		return
+	}
+	if err := r.store.Save(rules); err != nil && !errors.Is(err, ErrTaxRulesVersionExists) {
+		log.Printf("Error publishing tax rules version %q: %v", rules.Version, err)
+	}
+}
+
+// WatchSignals recarrega as regras a cada sinal recebido até o contexto ser cancelado
+func (r *TaxRulesReloader) WatchSignals(ctx context.Context, signals ...os.Signal) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-ch:
+			log.Printf("Received %s, reloading tax rules", sig)
+			r.reloadAndLog()
+		}
+	}
+}
+
+// WatchFile verifica o arquivo a cada intervalo e recarrega as regras quando
+// a data de modificação ou o tamanho mudam
+func (r *TaxRulesReloader) WatchFile(ctx context.Context, path string, interval time.Duration) {
+	lastModTime, lastSize := fileStamp(path)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			modTime, size := fileStamp(path)
+			if modTime.Equal(lastModTime) && size == lastSize {
+				continue
+			}
+			lastModTime, lastSize = modTime, size
+			log.Printf("Tax rules file %s changed, reloading", path)
+			r.reloadAndLog()
+		}
+	}
+}
+
+func (r *TaxRulesReloader) reloadAndLog() {
+	if err := r.Reload(); err != nil {
+		log.Printf("Error reloading tax rules, keeping version %q: %v", CurrentTaxRules().Version, err)
+	}
+}
+
+func fileStamp(path string) (time.Time, int64) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, -1
+	}
+	return info.ModTime(), info.Size()
+}
@@ -0,0 +1,244 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// TestTaxRulesReloader_KeepsPreviousRulesOnError testa que uma versão inválida
+// não substitui as regras ativas
+func TestTaxRulesReloader_KeepsPreviousRulesOnError(t *testing.T) {
+	original := CurrentTaxRules()
+	defer SetActiveTaxRules(original)
+
+	example, err := os.ReadFile("../resources/tax_rules.example.yaml")
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "tax_rules.yaml")
+	if err := os.WriteFile(path, example, 0o644); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	reloader := NewTaxRulesReloader(NewFileTaxTableProvider(path), nil)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if version := CurrentTaxRules().Version; version != "2026.1" {
+		t.Fatalf("Versão esperada 2026.1, obtida %q", version)
+	}
+
+	// Introduz uma lacuna entre a primeira e a segunda faixa do INSS de 2026
+	broken := strings.Replace(string(example), `init_value: "1621.01"`, `init_value: "1700.00"`, 1)
+	broken = strings.Replace(broken, `version: "2026.1"`, `version: "2026.2"`, 1)
+	if err := os.WriteFile(path, []byte(broken), 0o644); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	if err := reloader.Reload(); err == nil {
+		t.Error("Regras inválidas devem ser rejeitadas no reload")
+	}
+	if version := CurrentTaxRules().Version; version != "2026.1" {
+		t.Errorf("As regras anteriores devem continuar ativas. Versão obtida %q", version)
+	}
+}
+
+// writeExampleRules grava o arquivo de exemplo com a versão informada
+func writeExampleRules(t *testing.T, path, version string) {
+	t.Helper()
+	example, err := os.ReadFile("../resources/tax_rules.example.yaml")
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	content := strings.Replace(string(example), `version: "2026.1"`, fmt.Sprintf("version: %q", version), 1)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+}
+
+// TestTaxRulesReloader_WatchFile testa o reload quando o arquivo muda e que
+// um arquivo inválido mantém as regras anteriores
+func TestTaxRulesReloader_WatchFile(t *testing.T) {
+	original := CurrentTaxRules()
+	defer SetActiveTaxRules(original)
+
+	path := filepath.Join(t.TempDir(), "tax_rules.yaml")
+	writeExampleRules(t, path, "2026.1")
+	reloader := NewTaxRulesReloader(NewFileTaxTableProvider(path), nil)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.WatchFile(ctx, path, 5*time.Millisecond)
+
+	// O arquivo é regravado até a mudança ser vista, pois a primeira escrita
+	// pode acontecer antes de WatchFile registrar o estado inicial
+	deadline := time.Now().Add(2 * time.Second)
+	for CurrentTaxRules().Version != "2026.10" && time.Now().Before(deadline) {
+		writeExampleRules(t, path, "2026.10")
+		time.Sleep(20 * time.Millisecond)
+	}
+	if version := CurrentTaxRules().Version; version != "2026.10" {
+		t.Fatalf("Versão esperada 2026.10 após a mudança do arquivo, obtida %q", version)
+	}
+	// Só a série da versão ativa fica na métrica
+	if series := testutil.CollectAndCount(taxRulesInfo); series != 1 {
+		t.Errorf("Esperada uma série de versão ativa, obtidas %d", series)
+	}
+
+	// Espera o reload do arquivo inválido falhar antes de conferir as regras,
+	// para não passar sem que WatchFile tenha visto a mudança
+	failures := testutil.ToFloat64(taxRulesReloads.WithLabelValues("failure"))
+	if err := os.WriteFile(path, []byte("version: \"2026.11\"\ntables: []\n"), 0o644); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	deadline = time.Now().Add(2 * time.Second)
+	for testutil.ToFloat64(taxRulesReloads.WithLabelValues("failure")) == failures && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if testutil.ToFloat64(taxRulesReloads.WithLabelValues("failure")) == failures {
+		t.Fatalf("O reload do arquivo inválido deveria ter falhado")
+	}
+	if version := CurrentTaxRules().Version; version != "2026.10" {
+		t.Errorf("As regras anteriores devem continuar ativas. Versão obtida %q", version)
+	}
+}
+
+// TestTaxRulesReloader_WatchSignals testa o reload ao receber SIGHUP
+func TestTaxRulesReloader_WatchSignals(t *testing.T) {
+	original := CurrentTaxRules()
+	defer SetActiveTaxRules(original)
+
+	// Mantém o SIGHUP capturado durante todo o teste para que o sinal nunca
+	// encerre o processo antes de WatchSignals se registrar
+	guard := make(chan os.Signal, 1)
+	signal.Notify(guard, syscall.SIGHUP)
+	defer signal.Stop(guard)
+
+	path := filepath.Join(t.TempDir(), "tax_rules.yaml")
+	writeExampleRules(t, path, "2026.1")
+	reloader := NewTaxRulesReloader(NewFileTaxTableProvider(path), nil)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.WatchSignals(ctx, syscall.SIGHUP)
+
+	writeExampleRules(t, path, "2026.20")
+	deadline := time.Now().Add(2 * time.Second)
+	for CurrentTaxRules().Version != "2026.20" && time.Now().Before(deadline) {
+		if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+			t.Fatalf("Erro inesperado: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if version := CurrentTaxRules().Version; version != "2026.20" {
+		t.Errorf("Versão esperada 2026.20 após SIGHUP, obtida %q", version)
+	}
+}
+
+// TestTaxRulesReloader_RejectsChangedPublishedVersion testa que uma versão já
+// publicada não pode voltar com outras tabelas, para que o store e as regras
+// ativas não divirjam
+func TestTaxRulesReloader_RejectsChangedPublishedVersion(t *testing.T) {
+	original := CurrentTaxRules()
+	defer SetActiveTaxRules(original)
+
+	path := filepath.Join(t.TempDir(), "tax_rules.yaml")
+	writeExampleRules(t, path, "2026.1")
+	store := NewMemoryTaxRulesStore()
+	reloader := NewTaxRulesReloader(NewFileTaxTableProvider(path), store)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	published, _ := store.Get("2026.1")
+
+	// Recarregar o mesmo conteúdo é aceito
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	example, _ := os.ReadFile(path)
+	changed := strings.Replace(string(example), `dependent_deduction: "189.59"`, `dependent_deduction: "200.00"`, 1)
+	if err := os.WriteFile(path, []byte(changed), 0o644); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if err := reloader.Reload(); !errors.Is(err, ErrTaxRulesVersionExists) {
+		t.Errorf("Uma versão publicada com outras tabelas deve ser rejeitada. Obtido: %v", err)
+	}
+	if !CurrentTaxRules().SameTables(published) {
+		t.Error("As regras ativas devem continuar sendo as publicadas")
+	}
+}
+
+// TestTaxRulesReloader_KeepsActivatedVersion testa que a versão ativada pela
+// API continua ativa quando o arquivo muda e após um reinício, e que a versão
+// do provedor é apenas publicada
+func TestTaxRulesReloader_KeepsActivatedVersion(t *testing.T) {
+	original := CurrentTaxRules()
+	defer SetActiveTaxRules(original)
+
+	path := filepath.Join(t.TempDir(), "tax_rules.yaml")
+	writeExampleRules(t, path, "2026.1")
+	store, err := NewFileTaxRulesStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	reloader := NewTaxRulesReloader(NewFileTaxTableProvider(path), store)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	if err := store.Save(&TaxRules{Version: "2026.5", Tables: []TaxConfig{*newTaxConfig2026()}}); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if _, err := ActivateTaxRules(store, "2026.5"); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	writeExampleRules(t, path, "2026.10")
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if version := CurrentTaxRules().Version; version != "2026.5" {
+		t.Errorf("A versão ativada pela API deve continuar ativa após a recarga. Versão obtida %q", version)
+	}
+	if _, err := store.Get("2026.10"); err != nil {
+		t.Errorf("A versão do provedor deve ser publicada. Erro: %v", err)
+	}
+
+	// Um novo reloader com o mesmo store, como após um reinício
+	SetActiveTaxRules(original)
+	restarted := NewTaxRulesReloader(NewFileTaxTableProvider(path), store)
+	if err := restarted.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if version := CurrentTaxRules().Version; version != "2026.5" {
+		t.Errorf("A versão ativada pela API deve continuar ativa após o reinício. Versão obtida %q", version)
+	}
+
+	// Ativar a versão do provedor volta a usá-la
+	if _, err := ActivateTaxRules(store, "2026.10"); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if version := CurrentTaxRules().Version; version != "2026.10" {
+		t.Errorf("Versão esperada 2026.10, obtida %q", version)
+	}
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrTaxRulesVersionNotFound = errors.New("tax rules version not found")
+	ErrTaxRulesVersionExists   = errors.New("tax rules version already exists")
+	ErrTaxRulesInvalid         = errors.New("invalid tax rules")
+)
+
+// TaxRulesStore guarda as versões publicadas das regras de impostos e a
+// versão ativada pela API. Uma versão publicada não pode ser alterada, apenas
+// substituída por outra.
+type TaxRulesStore interface {
+	List() ([]*TaxRules, error)
+	Get(version string) (*TaxRules, error)
+	Save(rules *TaxRules) error
+	// SetActive guarda a versão ativada pela API
+	SetActive(version string) error
+	// Active retorna a versão ativada pela API ou "" se nenhuma foi ativada
+	Active() (string, error)
+}
+
+// activationMu impede que uma recarga do provedor e uma ativação pela API se
+// cruzem entre a leitura da versão ativada e a troca das regras ativas
+var activationMu sync.Mutex
+
+// ActivateTaxRules valida a versão guardada, grava no store que ela foi
+// ativada e a torna a versão ativa
+func ActivateTaxRules(store TaxRulesStore, version string) (*TaxRules, error) {
+	rules, err := store.Get(version)
+	if err != nil {
+		return nil, err
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, fmt.Errorf("%w version %q: %w", ErrTaxRulesInvalid, version, err)
+	}
+
+	activationMu.Lock()
+	defer activationMu.Unlock()
+
+	if err := store.SetActive(version); err != nil {
+		return nil, fmt.Errorf("saving the activated tax rules version %q: %w", version, err)
+	}
+	SetActiveTaxRules(rules)
+	return rules, nil
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestActivateTaxRules testa a publicação e ativação de versões no store em memória
+func TestActivateTaxRules(t *testing.T) {
+	original := CurrentTaxRules()
+	defer SetActiveTaxRules(original)
+
+	store := NewMemoryTaxRulesStore()
+	valid := &TaxRules{Version: "2026.1", Tables: []TaxConfig{*newTaxConfig2026()}}
+	if err := store.Save(valid); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if err := store.Save(&TaxRules{Version: "2026.1"}); !errors.Is(err, ErrTaxRulesVersionExists) {
+		t.Errorf("Publicar uma versão existente deve retornar ErrTaxRulesVersionExists. Obtido: %v", err)
+	}
+
+	broken := newTaxConfig2026()
+	broken.IRRFRanges[1].Deduction = broken.IRRFRanges[2].Deduction
+	if err := store.Save(&TaxRules{Version: "2026.2", Tables: []TaxConfig{*broken}}); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	if _, err := ActivateTaxRules(store, "2026.2"); !errors.Is(err, ErrTaxRulesInvalid) {
+		t.Errorf("Ativar uma versão inconsistente deve retornar ErrTaxRulesInvalid. Obtido: %v", err)
+	}
+	if _, err := ActivateTaxRules(store, "2027.1"); !errors.Is(err, ErrTaxRulesVersionNotFound) {
+		t.Errorf("Ativar uma versão inexistente deve retornar ErrTaxRulesVersionNotFound. Obtido: %v", err)
+	}
+	if _, err := ActivateTaxRules(store, "2026.1"); err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+	if version := CurrentTaxRules().Version; version != "2026.1" {
+		t.Errorf("Versão ativa esperada 2026.1, obtida %q", version)
+	}
+	if version, _ := store.Active(); version != "2026.1" {
+		t.Errorf("Versão ativada esperada no store 2026.1, obtida %q", version)
+	}
+
+	versions, _ := store.List()
+	if len(versions) != 2 {
+		t.Errorf("Esperadas 2 versões publicadas, obtidas %d", len(versions))
+	}
+}
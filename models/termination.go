@@ -0,0 +1,247 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// baseNoticeDays é o aviso prévio mínimo, acrescido de 3 dias por ano
+	// completo de serviço até 90 dias (Lei nº 12.506/2011)
+	baseNoticeDays    = 30
+	noticeDaysPerYear = 3
+	maxNoticeDays     = 90
+	// maxExpiredVacations limita os períodos vencidos acumulados. A dobra das
+	// férias não concedidas no prazo (art. 137 da CLT) não é calculada.
+	maxExpiredVacations = 2
+)
+
+// fgtsRate é a alíquota do depósito mensal do FGTS
+var fgtsRate = decimal.NewFromFloat(0.08)
+
+// TerminationType é a modalidade de desligamento, que define as verbas devidas
+type TerminationType string
+
+const (
+	// TerminationWithoutCause é a dispensa sem justa causa pelo empregador
+	TerminationWithoutCause TerminationType = "without_cause"
+	// TerminationResignation é o pedido de demissão do empregado
+	TerminationResignation TerminationType = "resignation"
+	// TerminationForCause é a dispensa por justa causa
+	TerminationForCause TerminationType = "for_cause"
+	// TerminationMutualAgreement é o acordo entre as partes (art. 484-A da CLT)
+	TerminationMutualAgreement TerminationType = "mutual_agreement"
+	// TerminationFixedTermEnd é o término do contrato por prazo determinado
+	TerminationFixedTermEnd TerminationType = "fixed_term_end"
+)
+
+var terminationTypeNames = map[TerminationType]string{
+	TerminationWithoutCause:    "Dispensa sem justa causa",
+	TerminationResignation:     "Pedido de demissão",
+	TerminationForCause:        "Dispensa por justa causa",
+	TerminationMutualAgreement: "Acordo entre as partes",
+	TerminationFixedTermEnd:    "Término de contrato por prazo determinado",
+}
+
+// terminationRules são as verbas devidas em cada modalidade
+type terminationRules struct {
+	// noticeShare é a parte do aviso prévio indenizado, que também projeta
+	// o tempo de serviço para os avos
+	noticeShare decimal.Decimal
+	// proportionalRights indica se o 13º e as férias proporcionais são devidos
+	proportionalRights bool
+	fgtsFineRate       decimal.Decimal
+}
+
+var terminationTypeRules = map[TerminationType]terminationRules{
+	TerminationWithoutCause:    {noticeShare: one, proportionalRights: true, fgtsFineRate: decimal.NewFromFloat(0.4)},
+	TerminationResignation:     {noticeShare: decimal.Zero, proportionalRights: true, fgtsFineRate: decimal.Zero},
+	TerminationForCause:        {noticeShare: decimal.Zero, proportionalRights: false, fgtsFineRate: decimal.Zero},
+	TerminationMutualAgreement: {noticeShare: decimal.NewFromFloat(0.5), proportionalRights: true, fgtsFineRate: decimal.NewFromFloat(0.2)},
+	TerminationFixedTermEnd:    {noticeShare: decimal.Zero, proportionalRights: true, fgtsFineRate: decimal.Zero},
+}
+
+func ParseTerminationType(value string) (TerminationType, error) {
+	terminationType := TerminationType(value)
+	if _, ok := terminationTypeNames[terminationType]; !ok {
+		return "", fmt.Errorf("invalid termination type %q", value)
+	}
+	return terminationType, nil
+}
+
+func (t TerminationType) String() string {
+	return terminationTypeNames[t]
+}
+
+// Termination são os dados do contrato encerrado
+type Termination struct {
+	Type      TerminationType
+	Admission time.Time
+	Date      time.Time
+	// MonthlySalary é o último salário mensal e VariableAverage a média das
+	// verbas variáveis dos últimos 12 meses, que integra o aviso, as férias e o 13º
+	MonthlySalary   decimal.Decimal
+	VariableAverage decimal.Decimal
+	// ExpiredVacations são os períodos aquisitivos completos sem gozo
+	ExpiredVacations int
+	// FGTSBalance é o saldo da conta vinculada para fins rescisórios
+	FGTSBalance decimal.Decimal
+}
+
+func (t Termination) Validate() error {
+	var errs []error
+	if _, err := ParseTerminationType(string(t.Type)); err != nil {
+		errs = append(errs, err)
+	}
+	if t.Date.Before(t.Admission) {
+		errs = append(errs, errors.New("termination is before admission"))
+	}
+	if t.MonthlySalary.IsNegative() || t.VariableAverage.IsNegative() || t.FGTSBalance.IsNegative() {
+		errs = append(errs, errors.New("amounts must not be negative"))
+	}
+	if t.ExpiredVacations < 0 || t.ExpiredVacations > maxExpiredVacations {
+		errs = append(errs, fmt.Errorf("expired vacations must be between 0 and %d", maxExpiredVacations))
+	}
+	return errors.Join(errs...)
+}
+
+// NoticeDays é o aviso prévio proporcional ao tempo de serviço
+func (t Termination) NoticeDays() int {
+	return min(baseNoticeDays+noticeDaysPerYear*completeYears(t.Admission, t.Date), maxNoticeDays)
+}
+
+func (t Termination) rules() terminationRules {
+	return terminationTypeRules[t.Type]
+}
+
+// ProjectedEnd é a data de desligamento somada ao aviso prévio indenizado,
+// que conta como tempo de serviço para o 13º e as férias proporcionais
+func (t Termination) ProjectedEnd() time.Time {
+	if t.rules().noticeShare.IsZero() {
+		return t.Date
+	}
+	return t.Date.AddDate(0, 0, t.NoticeDays())
+}
+
+// TerminationSettlement é o termo de rescisão (TRCT). As verbas do mês são
+// uma folha própria e o 13º proporcional outra, com INSS e IRRF de
+// tributação exclusiva. A multa do FGTS é depositada na conta vinculada e não
+// compõe o líquido.
+type TerminationSettlement struct {
+	Termination      Termination
+	NoticeDays       int
+	Settlement       *Payroll
+	ThirteenthSalary *Payroll
+	// FGTSDeposit é o depósito do FGTS sobre as verbas rescisórias
+	FGTSDeposit decimal.Decimal
+	// FGTSFine é a multa sobre o saldo da conta somado ao depósito rescisório
+	FGTSFine decimal.Decimal
+}
+
+// NewTerminationSettlement calcula as verbas rescisórias conforme a modalidade
+func NewTerminationSettlement(termination Termination, numberOfDependents int64, config *TaxConfig) (*TerminationSettlement, error) {
+	if err := termination.Validate(); err != nil {
+		return nil, err
+	}
+
+	rules := termination.rules()
+	remuneration := termination.MonthlySalary.Add(termination.VariableAverage)
+	projectedEnd := termination.ProjectedEnd()
+
+	proration, err := NewProration(CompetenceOf(termination.Date), &termination.Admission, &termination.Date, ProrationCommercial)
+	if err != nil {
+		return nil, err
+	}
+	earnings := []Earning{NewDaysEarning("Saldo de salário", termination.MonthlySalary, proration.DaysWorked, FullIncidence)}
+
+	settlement := &TerminationSettlement{Termination: termination}
+	if rules.noticeShare.IsPositive() {
+		settlement.NoticeDays = termination.NoticeDays()
+		name := "Aviso prévio indenizado"
+		if !rules.noticeShare.Equal(one) {
+			name = "Aviso prévio indenizado pela metade"
+		}
+		// O aviso indenizado não tem INSS nem IRRF, mas tem FGTS (Súmula 305 do TST)
+		earnings = append(earnings, NewDaysEarning(name, remuneration.Mul(rules.noticeShare), settlement.NoticeDays, Incidence{FGTS: true}))
+	}
+
+	// As férias indenizadas e o seu terço não têm incidência
+	if termination.ExpiredVacations > 0 {
+		expired := NewDaysEarning("Férias vencidas", remuneration, vacationEntitlementDays*termination.ExpiredVacations, Incidence{})
+		earnings = append(earnings, expired, NewOneThirdBonus("1/3 sobre férias vencidas", expired.Value(), Incidence{}))
+	}
+	if avos := VacationAvos(termination.Admission, projectedEnd); rules.proportionalRights && avos > 0 {
+		proportional := NewAvosEarning("Férias proporcionais", remuneration, avos, Incidence{})
+		earnings = append(earnings, proportional, NewOneThirdBonus("1/3 sobre férias proporcionais", proportional.Value(), Incidence{}))
+	}
+	settlement.Settlement = NewPayrollFromEarnings(earnings, numberOfDependents, config)
+
+	fgtsBase := settlement.Settlement.FGTSBase()
+	if avos := terminationThirteenthAvos(termination, projectedEnd); rules.proportionalRights && avos > 0 {
+		thirteenth := NewAvosEarning("13º salário proporcional", remuneration, avos, FullIncidence)
+		settlement.ThirteenthSalary = NewPayrollFromEarnings([]Earning{thirteenth}, numberOfDependents, config)
+		fgtsBase = fgtsBase.Add(settlement.ThirteenthSalary.FGTSBase())
+	}
+
+	settlement.FGTSDeposit = fgtsBase.Mul(fgtsRate).RoundBank(2)
+	settlement.FGTSFine = termination.FGTSBalance.Add(settlement.FGTSDeposit).Mul(rules.fgtsFineRate).RoundBank(2)
+	return settlement, nil
+}
+
+// terminationThirteenthAvos conta os avos do 13º do ano do desligamento até
+// a data projetada, que pode avançar para o ano seguinte
+func terminationThirteenthAvos(termination Termination, projectedEnd time.Time) Avos {
+	var avos Avos
+	for year := termination.Date.Year(); year <= projectedEnd.Year(); year++ {
+		avos += ThirteenthSalaryAvos(year, &termination.Admission, &projectedEnd)
+	}
+	return avos
+}
+
+// GrossPay é a soma das verbas rescisórias, inclusive o 13º
+func (s *TerminationSettlement) GrossPay() decimal.Decimal {
+	return s.sum(func(p *Payroll) decimal.Decimal { return p.GrossPay })
+}
+
+func (s *TerminationSettlement) TotalDiscount() decimal.Decimal {
+	return s.sum((*Payroll).TotalDiscount)
+}
+
+// NetPay é o valor líquido pago ao empregado, sem a multa do FGTS
+func (s *TerminationSettlement) NetPay() decimal.Decimal {
+	return s.sum((*Payroll).NetPay)
+}
+
+func (s *TerminationSettlement) sum(value func(*Payroll) decimal.Decimal) decimal.Decimal {
+	total := value(s.Settlement)
+	if s.ThirteenthSalary != nil {
+		total = total.Add(value(s.ThirteenthSalary))
+	}
+	return total
+}
+
+// VacationAvos conta os meses do período aquisitivo em curso até o
+// desligamento, com a fração de 15 dias ou mais contando como um mês
+func VacationAvos(admission, end time.Time) Avos {
+	start := admission.AddDate(completeYears(admission, end), 0, 0)
+	months := 0
+	for months < monthsPerYear && !start.AddDate(0, months+1, 0).After(end.AddDate(0, 0, 1)) {
+		months++
+	}
+	if months < monthsPerYear && daysBetween(start.AddDate(0, months, 0), end) >= minDaysForAvo {
+		months++
+	}
+	return Avos(months)
+}
+
+// completeYears conta os anos completos de serviço entre a admissão e a data
+func completeYears(admission, date time.Time) int {
+	years := date.Year() - admission.Year()
+	if admission.AddDate(years, 0, 0).After(date) {
+		years--
+	}
+	return max(years, 0)
+}
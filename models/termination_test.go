@@ -0,0 +1,171 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func newTestTermination(terminationType TerminationType) Termination {
+	return Termination{
+		Type:             terminationType,
+		Admission:        *date(2022, time.March, 10),
+		Date:             *date(2026, time.August, 20),
+		MonthlySalary:    decimal.NewFromFloat(3000.00),
+		ExpiredVacations: 1,
+		FGTSBalance:      decimal.NewFromFloat(10000.00),
+	}
+}
+
+func earningValues(earnings []Earning) map[string]decimal.Decimal {
+	values := make(map[string]decimal.Decimal, len(earnings))
+	for _, earning := range earnings {
+		values[earning.Name()] = earning.Value()
+	}
+	return values
+}
+
+// TestTerminationSettlement_WithoutCause testa a dispensa sem justa causa
+// após 4 anos completos: aviso de 42 dias projetado até 1º de outubro
+func TestTerminationSettlement_WithoutCause(t *testing.T) {
+	settlement, err := NewTerminationSettlement(newTestTermination(TerminationWithoutCause), 0, newTaxConfig2026())
+	if err != nil {
+		t.Fatalf("Erro inesperado: %v", err)
+	}
+
+	expected := map[string]float64{
+		"Saldo de salário (20 dias)":        2000.00,
+		"Aviso prévio indenizado (42 dias)": 4200.00,
+		"Férias vencidas (30 dias)":         3000.00,
+		"1/3 sobre férias vencidas":         1000.00,
+		"Férias proporcionais (7/12 avos)":  1750.00,
+		"1/3 sobre férias proporcionais":    583.33,
+	}
+	values := earningValues(settlement.Settlement.Earnings)
+	if len(values) != len(expected) {
+		t.Fatalf("Esperadas %d verbas, obtidas %d", len(expected), len(values))
+	}
+	for name, value := range expected {
+		if !values[name].Equal(decimal.NewFromFloat(value)) {
+			t.Errorf("%s esperado %.2f, obtido %s", name, value, values[name])
+		}
+	}
+
+	// Só o saldo de salário tem INSS e IRRF; o aviso tem FGTS
+	if !settlement.Settlement.INSSBase().Equal(decimal.NewFromFloat(2000.00)) || !settlement.Settlement.IRRFBase().Equal(decimal.NewFromFloat(2000.00)) {
+		t.Errorf("Bases do INSS e do IRRF esperadas 2000.00, obtidas %s e %s", settlement.Settlement.INSSBase(), settlement.Settlement.IRRFBase())
+	}
+	if !settlement.Settlement.FGTSBase().Equal(decimal.NewFromFloat(6200.00)) {
+		t.Errorf("Base do FGTS esperada 6200.00, obtida %s", settlement.Settlement.FGTSBase())
+	}
+
+	if settlement.ThirteenthSalary == nil || !settlement.ThirteenthSalary.GrossPay.Equal(decimal.NewFromFloat(2250.00)) {
+		t.Fatalf("13º proporcional de 9 avos esperado 2250.00")
+	}
+
+	// FGTS de 8% sobre 6.200,00 + 2.250,00 e multa de 40% sobre o saldo somado ao depósito
+	if !settlement.FGTSDeposit.Equal(decimal.NewFromFloat(676.00)) {
+		t.Errorf("Depósito do FGTS esperado 676.00, obtido %s", settlement.FGTSDeposit)
+	}
+	if !settlement.FGTSFine.Equal(decimal.NewFromFloat(4270.40)) {
+		t.Errorf("Multa do FGTS esperada 4270.40, obtida %s", settlement.FGTSFine)
+	}
+
+	expectedNet := settlement.Settlement.NetPay().Add(settlement.ThirteenthSalary.NetPay())
+	if !settlement.NetPay().Equal(expectedNet) {
+		t.Errorf("Líquido esperado %s, obtido %s", expectedNet, settlement.NetPay())
+	}
+}
+
+// TestTerminationSettlement_Types testa as verbas que mudam com a modalidade
+func TestTerminationSettlement_Types(t *testing.T) {
+	testCases := []struct {
+		name            string
+		terminationType TerminationType
+		notice          string
+		noticeValue     float64
+		proportional    bool
+		fine            float64
+	}{
+		{"Pedido de demissão", TerminationResignation, "", 0, true, 0},
+		{"Justa causa", TerminationForCause, "", 0, false, 0},
+		{"Acordo", TerminationMutualAgreement, "Aviso prévio indenizado pela metade (42 dias)", 2100.00, true, 2101.60},
+		{"Término de contrato", TerminationFixedTermEnd, "", 0, true, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			settlement, err := NewTerminationSettlement(newTestTermination(tc.terminationType), 0, newTaxConfig2026())
+			if err != nil {
+				t.Fatalf("Erro inesperado: %v", err)
+			}
+
+			values := earningValues(settlement.Settlement.Earnings)
+			if tc.notice != "" && !values[tc.notice].Equal(decimal.NewFromFloat(tc.noticeValue)) {
+				t.Errorf("%s esperado %.2f, obtido %s", tc.notice, tc.noticeValue, values[tc.notice])
+			}
+			if tc.notice == "" && settlement.NoticeDays != 0 {
+				t.Errorf("Aviso prévio indenizado não esperado, obtidos %d dias", settlement.NoticeDays)
+			}
+			_, vacation := values["Férias proporcionais (5/12 avos)"]
+			if tc.notice != "" {
+				_, vacation = values["Férias proporcionais (7/12 avos)"]
+			}
+			if vacation != tc.proportional {
+				t.Errorf("Férias proporcionais esperadas: %v", tc.proportional)
+			}
+			if (settlement.ThirteenthSalary != nil) != tc.proportional {
+				t.Errorf("13º proporcional esperado: %v", tc.proportional)
+			}
+			if !settlement.FGTSFine.Equal(decimal.NewFromFloat(tc.fine)) {
+				t.Errorf("Multa do FGTS esperada %.2f, obtida %s", tc.fine, settlement.FGTSFine)
+			}
+		})
+	}
+}
+
+// TestTermination_NoticeDays testa o aviso proporcional limitado a 90 dias
+func TestTermination_NoticeDays(t *testing.T) {
+	testCases := []struct {
+		name      string
+		admission *time.Time
+		expected  int
+	}{
+		{"Menos de 1 ano", date(2026, time.January, 5), 30},
+		{"1 dia antes de completar 1 ano", date(2025, time.August, 21), 30},
+		{"1 ano completo", date(2025, time.August, 20), 33},
+		{"Mais de 20 anos", date(2000, time.January, 1), 90},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			termination := Termination{Admission: *tc.admission, Date: *date(2026, time.August, 20)}
+			if days := termination.NoticeDays(); days != tc.expected {
+				t.Errorf("%s: aviso esperado de %d dias, obtido %d", tc.name, tc.expected, days)
+			}
+		})
+	}
+}
+
+// TestVacationAvos testa os avos do período aquisitivo em curso
+func TestVacationAvos(t *testing.T) {
+	testCases := []struct {
+		name     string
+		end      *time.Time
+		expected Avos
+	}{
+		{"Fração de 14 dias", date(2026, time.April, 23), 1},
+		{"Fração de 15 dias", date(2026, time.April, 24), 2},
+		{"Último dia do período", date(2026, time.March, 9), 12},
+		{"Primeiro dia do novo período", date(2026, time.March, 10), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if avos := VacationAvos(*date(2022, time.March, 10), *tc.end); avos != tc.expected {
+				t.Errorf("%s: esperados %d avos, obtidos %d", tc.name, tc.expected, avos)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package models
+
+import "github.com/shopspring/decimal"
+
+// firstInstallmentRate é a parte do 13º paga na primeira parcela
+var firstInstallmentRate = decimal.NewFromFloat(0.5)
+
+// ThirteenthSalary é o cálculo do 13º salário em duas parcelas. A primeira é
+// um adiantamento de metade do valor, sem impostos. A segunda é uma folha
+// própria: o INSS incide sobre o 13º integral e o IRRF é de tributação
+// exclusiva, sem somar com o salário do mês, e o adiantamento é descontado.
+type ThirteenthSalary struct {
+	Avos              Avos
+	FirstInstallment  decimal.Decimal
+	SecondInstallment *Payroll
+}
+
+// NewThirteenthSalary calcula o 13º proporcional aos avos sobre o salário
+// mensal e a média das verbas variáveis do ano
+func NewThirteenthSalary(monthlySalary, variableAverage decimal.Decimal, avos Avos, numberOfDependents int64, config *TaxConfig) *ThirteenthSalary {
+	earnings := []Earning{NewAvosEarning("13º salário", monthlySalary, avos, FullIncidence)}
+	if variableAverage.IsPositive() {
+		earnings = append(earnings, NewAvosEarning("Médias de variáveis do 13º", variableAverage, avos, FullIncidence))
+	}
+
+	firstInstallment := sumValues(earnings).Mul(firstInstallmentRate).RoundBank(2)
+
+	return &ThirteenthSalary{
+		Avos:             avos,
+		FirstInstallment: firstInstallment,
+		SecondInstallment: NewPayrollFromEarnings(earnings, numberOfDependents, config,
+			NewAdvanceDiscount("Adiantamento da 1ª parcela do 13º", firstInstallment)),
+	}
+}
+
+// Total é o valor bruto do 13º, base do INSS e do IRRF
+func (t *ThirteenthSalary) Total() decimal.Decimal {
+	return t.SecondInstallment.GrossPay
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestThirteenthSalaryAvos testa a contagem de meses com 15 dias ou mais
+func TestThirteenthSalaryAvos(t *testing.T) {
+	testCases := []struct {
+		name        string
+		admission   *time.Time
+		termination *time.Time
+		expected    Avos
+	}{
+		{"Ano inteiro", nil, nil, 12},
+		{"Admissão em 17 de março", date(2026, time.March, 17), nil, 10},
+		{"Admissão em 1º de março", date(2026, time.March, 1), nil, 10},
+		{"Admissão em 18 de março", date(2026, time.March, 18), nil, 9},
+		{"Desligamento em 14 de agosto", nil, date(2026, time.August, 14), 7},
+		{"Desligamento em 15 de agosto", nil, date(2026, time.August, 15), 8},
+		{"Admissão em ano anterior", date(2024, time.May, 20), nil, 12},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if avos := ThirteenthSalaryAvos(2026, tc.admission, tc.termination); avos != tc.expected {
+				t.Errorf("%s: esperados %d avos, obtidos %d", tc.name, tc.expected, avos)
+			}
+		})
+	}
+}
+
+// TestThirteenthSalary testa as duas parcelas com tributação exclusiva
+// Salário R$ 3.000,00 e média de variáveis de R$ 600,00, 12 avos
+func TestThirteenthSalary(t *testing.T) {
+	config := newTaxConfig2025()
+	thirteenth := NewThirteenthSalary(decimal.NewFromFloat(3000.00), decimal.NewFromFloat(600.00), 12, 0, config)
+
+	if !thirteenth.Total().Equal(decimal.NewFromFloat(3600.00)) {
+		t.Errorf("13º integral esperado 3600.00, obtido %s", thirteenth.Total())
+	}
+	if !thirteenth.FirstInstallment.Equal(decimal.NewFromFloat(1800.00)) {
+		t.Errorf("Primeira parcela esperada 1800.00, obtida %s", thirteenth.FirstInstallment)
+	}
+
+	// INSS e IRRF sobre o 13º integral de R$ 3.600,00, sem somar ao salário do mês
+	second := thirteenth.SecondInstallment
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		// 113,85 + 114,83 + 806,12 × 12%
+		{"INSS", second.Discounts[0].Value(), 325.41},
+		// (3.600,00 - 607,20) × 15% - 394,16
+		{"IRRF", second.Discounts[1].Value(), 54.76},
+		{"Adiantamento", second.Discounts[2].Value(), 1800.00},
+		// 3.600,00 - 325,41 - 54,76 - 1.800,00
+		{"Líquido da segunda parcela", second.NetPay(), 1419.83},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
+
+// TestThirteenthSalary_Proportional testa o 13º proporcional a 7 avos
+func TestThirteenthSalary_Proportional(t *testing.T) {
+	thirteenth := NewThirteenthSalary(decimal.NewFromFloat(3000.00), decimal.Zero, 7, 0, newTaxConfig2026())
+
+	if !thirteenth.Total().Equal(decimal.NewFromFloat(1750.00)) {
+		t.Errorf("13º de 7 avos esperado 1750.00, obtido %s", thirteenth.Total())
+	}
+	if name := thirteenth.SecondInstallment.Earnings[0].Name(); name != "13º salário (7/12 avos)" {
+		t.Errorf("Nome inesperado: %s", name)
+	}
+}
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// vacationEntitlementDays são os dias de férias de um período aquisitivo completo
+	vacationEntitlementDays = 30
+	// minVacationDays é o menor período de gozo permitido no fracionamento
+	minVacationDays = 5
+	// maxSoldVacationDays é o limite do abono pecuniário, um terço do período
+	maxSoldVacationDays = vacationEntitlementDays / 3
+)
+
+// thirdDivisor divide o valor de origem no terço constitucional
+var thirdDivisor = decimal.NewFromInt(3)
+
+// VacationPeriod são os dias de férias gozados e os vendidos como abono pecuniário
+type VacationPeriod struct {
+	Days     int
+	SoldDays int
+}
+
+// DefaultVacationDays são os dias gozados quando só o abono é informado: o
+// restante do período aquisitivo
+func DefaultVacationDays(soldDays int) int {
+	return vacationEntitlementDays - soldDays
+}
+
+func NewVacationPeriod(days, soldDays int) (VacationPeriod, error) {
+	period := VacationPeriod{Days: days, SoldDays: soldDays}
+	return period, period.Validate()
+}
+
+func (p VacationPeriod) Validate() error {
+	if p.Days < minVacationDays {
+		return fmt.Errorf("vacation days must be at least %d", minVacationDays)
+	}
+	if p.SoldDays < 0 || p.SoldDays > maxSoldVacationDays {
+		return fmt.Errorf("sold vacation days must be between 0 and %d", maxSoldVacationDays)
+	}
+	if p.Days+p.SoldDays > vacationEntitlementDays {
+		return errors.New("vacation and sold days exceed the entitlement")
+	}
+	return nil
+}
+
+// Vacation é o recibo de férias: os dias gozados com o terço constitucional,
+// que têm incidência de INSS, IRRF e FGTS, e o abono pecuniário com o seu
+// terço, que é indenizatório e isento. O IRRF do recibo é calculado à parte
+// da folha do mês.
+type Vacation struct {
+	Period  VacationPeriod
+	Receipt *Payroll
+}
+
+// NewVacation calcula as férias sobre o salário mensal e a média das verbas
+// variáveis dos últimos 12 meses
+func NewVacation(monthlySalary, variableAverage decimal.Decimal, period VacationPeriod, numberOfDependents int64, config *TaxConfig) *Vacation {
+	vacationPay := []Earning{NewDaysEarning("Férias", monthlySalary, period.Days, FullIncidence)}
+	if variableAverage.IsPositive() {
+		vacationPay = append(vacationPay, NewDaysEarning("Médias de variáveis das férias", variableAverage, period.Days, FullIncidence))
+	}
+	earnings := append(vacationPay, NewOneThirdBonus("1/3 constitucional de férias", sumValues(vacationPay), FullIncidence))
+
+	if period.SoldDays > 0 {
+		remuneration := monthlySalary.Add(variableAverage)
+		abono := NewDaysEarning("Abono pecuniário", remuneration, period.SoldDays, Incidence{})
+		earnings = append(earnings, abono, NewOneThirdBonus("1/3 sobre abono pecuniário", abono.Value(), Incidence{}))
+	}
+
+	return &Vacation{
+		Period:  period,
+		Receipt: NewPayrollFromEarnings(earnings, numberOfDependents, config),
+	}
+}
+
+func sumValues(earnings []Earning) decimal.Decimal {
+	total := decimal.Zero
+	for _, earning := range earnings {
+		total = total.Add(earning.Value())
+	}
+	return total
+}
+
+// DaysEarning é um provento calculado em dias sobre um valor mensal, pelo
+// mês comercial de 30 dias, como as férias e o abono pecuniário
+type DaysEarning struct {
+	name          string
+	monthlyAmount decimal.Decimal
+	days          int
+	incidence     Incidence
+}
+
+func NewDaysEarning(name string, monthlyAmount decimal.Decimal, days int, incidence Incidence) *DaysEarning {
+	return &DaysEarning{
+		name:          name,
+		monthlyAmount: monthlyAmount,
+		days:          days,
+		incidence:     incidence,
+	}
+}
+
+func (d DaysEarning) Value() decimal.Decimal {
+	return DailyRate(d.monthlyAmount).Mul(decimal.NewFromInt(int64(d.days))).RoundBank(2)
+}
+
+func (d DaysEarning) Name() string {
+	return fmt.Sprintf("%s (%s)", d.name, countLabel(decimal.NewFromInt(int64(d.days)), "dia", "dias"))
+}
+
+func (d DaysEarning) Incidence() Incidence {
+	return d.incidence
+}
+
+// OneThirdBonus é o terço constitucional sobre as férias ou sobre o abono,
+// com a mesma incidência do valor de origem
+type OneThirdBonus struct {
+	name      string
+	base      decimal.Decimal
+	incidence Incidence
+}
+
+func NewOneThirdBonus(name string, base decimal.Decimal, incidence Incidence) *OneThirdBonus {
+	return &OneThirdBonus{
+		name:      name,
+		base:      base,
+		incidence: incidence,
+	}
+}
+
+func (o OneThirdBonus) Value() decimal.Decimal {
+	return o.base.Div(thirdDivisor).RoundBank(2)
+}
+
+func (o OneThirdBonus) Name() string {
+	return o.name
+}
+
+func (o OneThirdBonus) Incidence() Incidence {
+	return o.incidence
+}
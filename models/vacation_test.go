@@ -0,0 +1,106 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestVacation_Full testa 30 dias de férias com o terço constitucional
+func TestVacation_Full(t *testing.T) {
+	period, _ := NewVacationPeriod(30, 0)
+	receipt := NewVacation(decimal.NewFromFloat(3000.00), decimal.Zero, period, 0, newTaxConfig2026()).Receipt
+
+	if !receipt.GrossPay.Equal(decimal.NewFromFloat(4000.00)) {
+		t.Errorf("Férias com 1/3 esperadas 4000.00, obtidas %s", receipt.GrossPay)
+	}
+	if !receipt.INSSBase().Equal(receipt.GrossPay) || !receipt.IRRFBase().Equal(receipt.GrossPay) {
+		t.Errorf("Férias e 1/3 devem compor as bases do INSS e do IRRF")
+	}
+}
+
+// TestVacation_WithAbono testa 20 dias de férias com 10 dias vendidos
+// Salário R$ 3.000,00 e média de variáveis de R$ 600,00
+func TestVacation_WithAbono(t *testing.T) {
+	config := newTaxConfig2026()
+	period, _ := NewVacationPeriod(20, 10)
+	receipt := NewVacation(decimal.NewFromFloat(3000.00), decimal.NewFromFloat(600.00), period, 0, config).Receipt
+
+	expected := map[string]float64{
+		"Férias (20 dias)":                         2000.00,
+		"Médias de variáveis das férias (20 dias)": 400.00,
+		"1/3 constitucional de férias":             800.00,
+		"Abono pecuniário (10 dias)":               1200.00,
+		"1/3 sobre abono pecuniário":               400.00,
+	}
+	if len(receipt.Earnings) != len(expected) {
+		t.Fatalf("Esperados %d proventos, obtidos %d", len(expected), len(receipt.Earnings))
+	}
+	for _, earning := range receipt.Earnings {
+		if !earning.Value().Equal(decimal.NewFromFloat(expected[earning.Name()])) {
+			t.Errorf("%s esperado %.2f, obtido %s", earning.Name(), expected[earning.Name()], earning.Value())
+		}
+	}
+
+	// O abono e o seu terço são isentos
+	taxed := decimal.NewFromFloat(3200.00)
+	if !receipt.INSSBase().Equal(taxed) || !receipt.IRRFBase().Equal(taxed) || !receipt.FGTSBase().Equal(taxed) {
+		t.Errorf("Bases esperadas 3200.00, obtidas INSS %s, IRRF %s, FGTS %s", receipt.INSSBase(), receipt.IRRFBase(), receipt.FGTSBase())
+	}
+
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		// 121,57 + 115,37 + 297,16 × 12%
+		{"INSS", receipt.Discounts[0].Value(), 272.59},
+		// Zerado pela redução da Lei nº 15.270/2025
+		{"IRRF", receipt.Discounts[1].Value(), 0.00},
+		// 4.800,00 - 272,59
+		{"Líquido", receipt.NetPay(), 4527.41},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
+
+// TestDefaultVacationDays testa que, sem os dias gozados, as férias completam
+// o período aquisitivo com o abono
+func TestDefaultVacationDays(t *testing.T) {
+	for _, soldDays := range []int{0, 5, 10} {
+		days := DefaultVacationDays(soldDays)
+		if _, err := NewVacationPeriod(days, soldDays); err != nil || days+soldDays != 30 {
+			t.Errorf("Abono de %d dias: esperados %d dias de férias válidos, obtidos %d (%v)", soldDays, 30-soldDays, days, err)
+		}
+	}
+}
+
+// TestVacationPeriod_Validate testa os limites de dias gozados e vendidos
+func TestVacationPeriod_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		days     int
+		soldDays int
+		valid    bool
+	}{
+		{"30 dias", 30, 0, true},
+		{"20 dias com abono", 20, 10, true},
+		{"Período fracionado de 5 dias", 5, 0, true},
+		{"Menos de 5 dias", 4, 0, false},
+		{"Abono acima de 10 dias", 19, 11, false},
+		{"Abono negativo", 30, -1, false},
+		{"Acima do período aquisitivo", 25, 10, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewVacationPeriod(tc.days, tc.soldDays)
+			if (err == nil) != tc.valid {
+				t.Errorf("%s: válido esperado %v, erro %v", tc.name, tc.valid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// HazardPremiumRate é o adicional de periculosidade sobre o salário base
+var HazardPremiumRate = decimal.NewFromFloat(0.3)
+
+// InsalubrityGrade é o grau de insalubridade, que define o percentual do
+// adicional sobre o salário mínimo
+type InsalubrityGrade string
+
+const (
+	InsalubrityNone    InsalubrityGrade = ""
+	InsalubrityMinimum InsalubrityGrade = "minimum"
+	InsalubrityMedium  InsalubrityGrade = "medium"
+	InsalubrityMaximum InsalubrityGrade = "maximum"
+)
+
+var insalubrityRates = map[InsalubrityGrade]decimal.Decimal{
+	InsalubrityNone:    decimal.Zero,
+	InsalubrityMinimum: decimal.NewFromFloat(0.1),
+	InsalubrityMedium:  decimal.NewFromFloat(0.2),
+	InsalubrityMaximum: decimal.NewFromFloat(0.4),
+}
+
+func ParseInsalubrityGrade(value string) (InsalubrityGrade, error) {
+	grade := InsalubrityGrade(value)
+	if _, ok := insalubrityRates[grade]; !ok {
+		return InsalubrityNone, fmt.Errorf("invalid insalubrity grade %q: expected minimum, medium or maximum", value)
+	}
+	return grade, nil
+}
+
+// Rate é o percentual do adicional para o grau
+func (g InsalubrityGrade) Rate() decimal.Decimal {
+	return insalubrityRates[g]
+}
+
+// HazardPremium é o adicional de periculosidade, 30% do salário base
+type HazardPremium struct {
+	baseSalary decimal.Decimal
+}
+
+func NewHazardPremium(baseSalary decimal.Decimal) *HazardPremium {
+	return &HazardPremium{
+		baseSalary: baseSalary,
+	}
+}
+
+func (h HazardPremium) Value() decimal.Decimal {
+	return h.baseSalary.Mul(HazardPremiumRate).RoundBank(2)
+}
+
+func (h HazardPremium) Name() string {
+	return "Adicional de periculosidade"
+}
+
+func (h HazardPremium) Incidence() Incidence {
+	return FullIncidence
+}
+
+// InsalubrityPremium é o adicional de insalubridade, 10%, 20% ou 40% do
+// salário mínimo de referência conforme o grau, proporcional aos dias
+// trabalhados nos meses de admissão e de desligamento
+type InsalubrityPremium struct {
+	minimumWage decimal.Decimal
+	grade       InsalubrityGrade
+	proration   Proration
+}
+
+// NewInsalubrityPremium cria o adicional do mês inteiro. Proration{} também
+// representa o mês inteiro.
+func NewInsalubrityPremium(minimumWage decimal.Decimal, grade InsalubrityGrade, proration Proration) *InsalubrityPremium {
+	return &InsalubrityPremium{
+		minimumWage: minimumWage,
+		grade:       grade,
+		proration:   proration,
+	}
+}
+
+func (i InsalubrityPremium) Value() decimal.Decimal {
+	value := i.minimumWage.Mul(i.grade.Rate())
+	if !i.proration.IsFull() {
+		value = i.proration.Apply(value)
+	}
+	return value.RoundBank(2)
+}
+
+// Prorated retorna o adicional do mês inteiro e os dias trabalhados
+func (i InsalubrityPremium) Prorated() (decimal.Decimal, Proration) {
+	return i.minimumWage.Mul(i.grade.Rate()).RoundBank(2), i.proration
+}
+
+func (i InsalubrityPremium) Name() string {
+	name := fmt.Sprintf("Adicional de insalubridade %s%%", i.grade.Rate().Shift(2))
+	if !i.proration.IsFull() {
+		name += fmt.Sprintf(" (%d/%d dias)", i.proration.DaysWorked, i.proration.MonthDays)
+	}
+	return name
+}
+
+func (i InsalubrityPremium) Incidence() Incidence {
+	return FullIncidence
+}
+
+// WorkConditionPremium escolhe entre periculosidade e insalubridade. Os
+// adicionais não são cumulativos: quando o empregado tem direito aos dois,
+// é pago o de maior valor. Retorna nil quando não há direito a nenhum.
+// O baseSalary já deve ser o salário proporcional do mês, e a proporção é
+// aplicada à insalubridade, que parte do salário mínimo mensal.
+func WorkConditionPremium(baseSalary decimal.Decimal, hazardous bool, minimumWage decimal.Decimal, grade InsalubrityGrade, proration Proration) Earning {
+	var premium Earning
+	if hazardous {
+		premium = NewHazardPremium(baseSalary)
+	}
+	if grade != InsalubrityNone {
+		insalubrity := NewInsalubrityPremium(minimumWage, grade, proration)
+		if premium == nil || insalubrity.Value().GreaterThan(premium.Value()) {
+			premium = insalubrity
+		}
+	}
+	return premium
+}
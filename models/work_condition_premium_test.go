@@ -0,0 +1,107 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TestWorkConditionPremium testa a escolha do maior adicional entre
+// periculosidade e insalubridade, com salário mínimo de R$ 1.621,00
+func TestWorkConditionPremium(t *testing.T) {
+	minimumWage := decimal.NewFromFloat(1621.00)
+
+	testCases := []struct {
+		name         string
+		baseSalary   float64
+		hazardous    bool
+		grade        InsalubrityGrade
+		expectedName string
+		expected     float64
+	}{
+		{"Só periculosidade", 3000.00, true, InsalubrityNone, "Adicional de periculosidade", 900.00},
+		{"Só insalubridade em grau mínimo", 3000.00, false, InsalubrityMinimum, "Adicional de insalubridade 10%", 162.10},
+		{"Só insalubridade em grau médio", 3000.00, false, InsalubrityMedium, "Adicional de insalubridade 20%", 324.20},
+		{"Periculosidade maior que insalubridade", 3000.00, true, InsalubrityMaximum, "Adicional de periculosidade", 900.00},
+		{"Insalubridade maior que periculosidade", 2000.00, true, InsalubrityMaximum, "Adicional de insalubridade 40%", 648.40},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			premium := WorkConditionPremium(decimal.NewFromFloat(tc.baseSalary), tc.hazardous, minimumWage, tc.grade, Proration{})
+			if premium == nil {
+				t.Fatalf("%s: adicional esperado", tc.name)
+			}
+			if premium.Name() != tc.expectedName {
+				t.Errorf("%s: adicional esperado %q, obtido %q", tc.name, tc.expectedName, premium.Name())
+			}
+			if !premium.Value().Equal(decimal.NewFromFloat(tc.expected)) {
+				t.Errorf("%s: valor esperado %.2f, obtido %s", tc.name, tc.expected, premium.Value())
+			}
+		})
+	}
+
+	if premium := WorkConditionPremium(decimal.NewFromFloat(3000.00), false, minimumWage, InsalubrityNone, Proration{}); premium != nil {
+		t.Errorf("Sem periculosidade nem insalubridade não deve haver adicional, obtido %q", premium.Name())
+	}
+}
+
+// TestWorkConditionPremium_Prorated testa a insalubridade proporcional na
+// admissão em 16 de janeiro de 2026, com 15 dias trabalhados no mês comercial
+func TestWorkConditionPremium_Prorated(t *testing.T) {
+	proration, err := NewProration(NewCompetence(2026, time.January), date(2026, time.January, 16), nil, ProrationCommercial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salary := NewProportionalSalary(decimal.NewFromFloat(2000.00), proration)
+
+	// Periculosidade de 30% sobre R$ 1.000,00 contra 40% de R$ 1.621,00 × 15/30
+	premium := WorkConditionPremium(salary.Value(), true, decimal.NewFromFloat(1621.00), InsalubrityMaximum, proration)
+	if premium == nil {
+		t.Fatal("Adicional esperado")
+	}
+	if premium.Name() != "Adicional de insalubridade 40% (15/30 dias)" {
+		t.Errorf("Nome inesperado: %s", premium.Name())
+	}
+	if !premium.Value().Equal(decimal.NewFromFloat(324.20)) {
+		t.Errorf("Valor esperado 324.20, obtido %s", premium.Value())
+	}
+
+	prorated, ok := premium.(ProratedEarning)
+	if !ok {
+		t.Fatal("A insalubridade deve informar o valor do mês inteiro e os dias trabalhados")
+	}
+	// 40% de R$ 1.621,00
+	if monthly, days := prorated.Prorated(); !monthly.Equal(decimal.NewFromFloat(648.40)) || days != proration {
+		t.Errorf("Esperado adicional mensal de 648.40 em 15/30 dias, obtido %s em %d/%d dias", monthly, days.DaysWorked, days.MonthDays)
+	}
+}
+
+// TestPayroll_WorkConditionPremiumInBases testa que o adicional entra nas bases do INSS e do IRRF
+func TestPayroll_WorkConditionPremiumInBases(t *testing.T) {
+	salary := decimal.NewFromFloat(3000.00)
+	earnings := []Earning{NewBaseSalary(salary), WorkConditionPremium(salary, true, decimal.Zero, InsalubrityNone, Proration{})}
+
+	payroll := NewPayrollFromEarnings(earnings, 0, newTaxConfig2025())
+
+	// Periculosidade de 30% sobre R$ 3.000,00
+	expectations := []struct {
+		name     string
+		obtained decimal.Decimal
+		expected float64
+	}{
+		{"Base do INSS", payroll.INSSBase(), 3900.00},
+		{"Base do IRRF", payroll.IRRFBase(), 3900.00},
+		// 113,85 + 114,83 + 1.106,12 × 12%
+		{"INSS", payroll.Discounts[0].Value(), 361.41},
+		// (3.900,00 - 607,20) × 15% - 394,16
+		{"IRRF", payroll.Discounts[1].Value(), 99.76},
+		{"Líquido", payroll.NetPay(), 3438.83},
+	}
+	for _, e := range expectations {
+		if !e.obtained.Equal(decimal.NewFromFloat(e.expected)) {
+			t.Errorf("%s esperado %.2f, obtido %s", e.name, e.expected, e.obtained)
+		}
+	}
+}
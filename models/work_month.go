@@ -0,0 +1,57 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// WorkMonth guarda os dias úteis e os dias de descanso (domingos e feriados)
+// do mês, usados no reflexo das verbas variáveis sobre o descanso semanal
+// remunerado (DSR)
+type WorkMonth struct {
+	BusinessDays int `json:"business_days"`
+	RestDays     int `json:"rest_days"`
+}
+
+func NewWorkMonth(businessDays, restDays int) (WorkMonth, error) {
+	month := WorkMonth{BusinessDays: businessDays, RestDays: restDays}
+	return month, month.Validate()
+}
+
+// WorkMonthOf conta os domingos da competência como dias de descanso e os
+// demais dias, inclusive sábados, como úteis. Feriados não são conhecidos e
+// devem ser informados com NewWorkMonth.
+func WorkMonthOf(competence Competence) WorkMonth {
+	var month WorkMonth
+	day := time.Date(competence.Year, competence.Month, 1, 0, 0, 0, 0, time.UTC)
+	for ; day.Month() == competence.Month; day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Sunday {
+			month.RestDays++
+		} else {
+			month.BusinessDays++
+		}
+	}
+	return month
+}
+
+func (m WorkMonth) Validate() error {
+	if m.BusinessDays <= 0 {
+		return errors.New("business days must be positive")
+	}
+	if m.RestDays < 0 {
+		return errors.New("rest days must not be negative")
+	}
+	return nil
+}
+
+// DSR calcula o reflexo de um valor variável sobre o descanso semanal
+// remunerado: o valor dividido pelos dias úteis e multiplicado pelos dias de
+// descanso
+func (m WorkMonth) DSR(amount decimal.Decimal) decimal.Decimal {
+	if m.BusinessDays <= 0 {
+		return decimal.Zero
+	}
+	return amount.Div(decimal.NewFromInt(int64(m.BusinessDays))).Mul(decimal.NewFromInt(int64(m.RestDays)))
+}